@@ -0,0 +1,227 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math"
+	"unicode/utf8"
+)
+
+// BatchGenerator is implemented by generators that can generate many IDs in a single call.
+type BatchGenerator interface {
+	// NewN generates 'count' Nano IDs of the specified length in a single batch,
+	// amortizing random reads and allocations across the batch.
+	//
+	// Usage:
+	//   ids, err := generator.NewN(1000, 21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	NewN(count, length int) ([]ID, error)
+
+	// NewBatchProgress generates 'count' Nano IDs of the specified length, calling onProgress
+	// with the number generated so far after every 1000 IDs and after the final ID.
+	//
+	// Usage:
+	//   ids, err := generator.NewBatchProgress(100000, 21, func(done int) {
+	//       fmt.Printf("\r%d IDs generated", done)
+	//   })
+	//   if err != nil {
+	//       // handle error
+	//   }
+	NewBatchProgress(count, length int, onProgress func(done int)) ([]ID, error)
+}
+
+// batchProgressInterval is the number of IDs generated between progress callbacks.
+const batchProgressInterval = 1000
+
+// NewBatchProgress generates 'count' Nano IDs of the specified length, reporting progress
+// through onProgress as the batch is generated.
+//
+// The IDs are generated in chunks of 1000 with the same single-pass batch generation as NewN.
+// onProgress is called with the number of IDs generated so far after every chunk, so a batch of
+// 2500 reports 1000, 2000 and 2500. It is invoked synchronously on the calling goroutine and
+// should return quickly. A nil onProgress disables reporting.
+//
+// Parameters:
+//   - count int: The number of IDs to generate.
+//   - length int: The desired number of characters in each ID.
+//   - onProgress func(done int): The progress callback. It may be nil.
+//
+// Returns:
+//   - []ID: The generated IDs.
+//   - error: An error object if generation fails; no partial batch is returned.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if count or length is less than or equal to zero, or if the
+//     batch would be too large to allocate.
+//   - ErrLengthTooShort: Returned if length is below the configured minimum length.
+//
+// Usage Example:
+//
+//	ids, err := generator.NewBatchProgress(100000, 21, func(done int) {
+//	    fmt.Printf("\r%d IDs generated", done)
+//	})
+//	if err != nil {
+//	    // handle error
+//	}
+func (g *generator) NewBatchProgress(count, length int, onProgress func(done int)) ([]ID, error) {
+	if err := g.checkBatch(count, length); err != nil {
+		return nil, err
+	}
+
+	ids := make([]ID, 0, count)
+	for len(ids) < count {
+		chunk, err := g.newBatch(min(batchProgressInterval, count-len(ids)), length)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, chunk...)
+
+		if onProgress != nil {
+			onProgress(len(ids))
+		}
+	}
+
+	return ids, nil
+}
+
+// NewN generates 'count' Nano IDs of the default length using the package-level Generator.
+// If Generator has been replaced by an implementation that is not a BatchGenerator, the IDs
+// are generated one at a time with New.
+//
+// Usage:
+//
+//	ids, err := nanoid.NewN(100)
+//	if err != nil {
+//	    // handle error
+//	}
+func NewN(count int) ([]ID, error) {
+	if b, ok := Generator.(BatchGenerator); ok {
+		return b.NewN(count, DefaultLength)
+	}
+
+	if count <= 0 {
+		return nil, ErrInvalidLength
+	}
+
+	ids := make([]ID, count)
+	for i := range ids {
+		id, err := Generator.New(DefaultLength)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+
+	return ids, nil
+}
+
+// NewN generates 'count' Nano IDs of the specified length in a single batch.
+//
+// For generators without per-ID constraints, the characters for the whole batch are drawn in one
+// pass that reuses a single pooled random-bytes buffer, amortizing reader calls and allocations
+// across all IDs. The IDs then share one backing string, so retaining any one of them keeps the
+// whole batch in memory. Generators with constraints, such as a leading alphabet or region code,
+// fall back to generating each ID individually.
+//
+// Parameters:
+//   - count int: The number of IDs to generate.
+//   - length int: The desired number of characters in each ID.
+//
+// Returns:
+//   - []ID: The generated IDs.
+//   - error: An error object if generation fails; no partial batch is returned.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if count or length is less than or equal to zero, or if the
+//     batch would be too large to allocate.
+//   - ErrLengthTooShort: Returned if length is below the configured minimum length.
+//
+// Usage Example:
+//
+//	ids, err := generator.NewN(1000, 21)
+//	if err != nil {
+//	    // handle error
+//	}
+func (g *generator) NewN(count, length int) ([]ID, error) {
+	if err := g.checkBatch(count, length); err != nil {
+		return nil, err
+	}
+
+	return g.newBatch(count, length)
+}
+
+// checkBatch validates the size of a requested batch of IDs.
+func (g *generator) checkBatch(count, length int) error {
+	if count <= 0 {
+		return ErrInvalidLength
+	}
+
+	if err := g.checkLength(length); err != nil {
+		return err
+	}
+
+	// The whole batch is drawn into one buffer of up to maxBytesPerRune bytes per character,
+	// so its size must not overflow.
+	if length > math.MaxInt/g.config.maxBytesPerRune {
+		return ErrInvalidLength
+	}
+	if count > math.MaxInt/(length*g.config.maxBytesPerRune) {
+		return ErrInvalidLength
+	}
+
+	return nil
+}
+
+// newBatch generates a validated batch of IDs, drawing the characters of the whole batch in
+// one pass when the generator has no per-ID constraints.
+func (g *generator) newBatch(count, length int) ([]ID, error) {
+	ids := make([]ID, count)
+	if !g.plain {
+		for i := range ids {
+			id, err := g.generate(g.config.randReader, length)
+			if err != nil {
+				return nil, err
+			}
+			ids[i] = id
+		}
+		return ids, nil
+	}
+
+	var all string
+	if g.config.isASCII {
+		buf := make([]byte, count*length)
+		if err := g.fillASCII(g.config.randReader, buf); err != nil {
+			return nil, err
+		}
+		all = string(buf)
+	} else {
+		runes := make([]rune, count*length)
+		if err := g.fillUnicode(g.config.randReader, runes); err != nil {
+			return nil, err
+		}
+		all = string(runes)
+	}
+
+	// Slice the batch into IDs, stepping by bytes for ASCII and by runes otherwise.
+	offset := 0
+	for i := range ids {
+		end := offset
+		if g.config.isASCII {
+			end += length
+		} else {
+			for n := 0; n < length; n++ {
+				_, size := utf8.DecodeRuneInString(all[end:])
+				end += size
+			}
+		}
+		ids[i] = ID(all[offset:end])
+		offset = end
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewBatchProgress tests that the progress callback fires at the documented points.
+func TestNewBatchProgress(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		count    int
+		expected []int
+	}{
+		{1, []int{1}},
+		{1000, []int{1000}},
+		{2500, []int{1000, 2000, 2500}},
+	}
+
+	for _, tt := range tests {
+		var reported []int
+		ids, err := Generator.(BatchGenerator).NewBatchProgress(tt.count, DefaultLength, func(done int) {
+			reported = append(reported, done)
+		})
+		is.NoError(err, "NewBatchProgress(%d) should not return an error", tt.count)
+		is.Len(ids, tt.count, "NewBatchProgress should return the requested number of IDs")
+		is.Equal(tt.expected, reported, "Unexpected progress reports for count %d", tt.count)
+
+		for _, id := range ids {
+			is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+		}
+	}
+
+	ids, err := Generator.(BatchGenerator).NewBatchProgress(10, DefaultLength, nil)
+	is.NoError(err, "A nil callback should be allowed")
+	is.Len(ids, 10)
+}
+
+// TestNewBatchProgressErrors tests NewBatchProgress with invalid arguments.
+func TestNewBatchProgressErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// NewBatchProgress validates its arguments exactly as NewN does.
+	for _, args := range [][2]int{{0, 21}, {-1, 21}, {10, 0}, {10, -1}, {math.MaxInt / 2, 21}} {
+		_, err := Generator.(BatchGenerator).NewBatchProgress(args[0], args[1], nil)
+		is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for count=%d, length=%d", args[0], args[1])
+	}
+}
+
+// TestNewN tests batch generation through the package-level function and generators.
+func TestNewN(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ids, err := NewN(100)
+	is.NoError(err, "NewN should not return an error")
+	is.Len(ids, 100)
+
+	seen := make(map[ID]struct{}, len(ids))
+	for _, id := range ids {
+		is.Len(id, DefaultLength, "Each ID should have the default length")
+		is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+		seen[id] = struct{}{}
+	}
+	is.Len(seen, len(ids), "IDs in a batch should be unique")
+
+	unicodeGen, err := NewGenerator(WithAlphabet("αβγδεζηθ"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	ids, err = unicodeGen.(BatchGenerator).NewN(50, 7)
+	is.NoError(err, "NewN should not return an error for a Unicode alphabet")
+	is.Len(ids, 50)
+	for _, id := range ids {
+		is.Equal(7, id.Len(), "Each Unicode ID should have the requested number of characters")
+		is.True(isValidID(id, "αβγδεζηθ"), "Generated ID contains invalid characters")
+	}
+
+	regionGen, err := NewGenerator(WithRegionCode("eu"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	ids, err = regionGen.(BatchGenerator).NewN(10, 8)
+	is.NoError(err, "NewN should not return an error for a constrained generator")
+	for _, id := range ids {
+		is.Len(id, 10, "Constrained IDs should include their decorations")
+		is.True(id.HasPrefix("eu"), "Constrained IDs should carry the region code")
+	}
+}
+
+// TestNewNErrors tests NewN with invalid arguments.
+func TestNewNErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, args := range [][2]int{{0, 21}, {-1, 21}, {10, 0}, {10, -1}, {math.MaxInt / 2, 21}} {
+		_, err := Generator.(BatchGenerator).NewN(args[0], args[1])
+		is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for count=%d, length=%d", args[0], args[1])
+	}
+
+	_, err := NewN(0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a zero count")
+
+	gen, err := NewGenerator(WithRandReader(&alwaysFailRandReader{}))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.(BatchGenerator).NewN(10, DefaultLength)
+	is.Error(err, "NewN should report a failing random reader")
+
+	// With 4-byte runes, length*maxBytesPerRune overflows to zero; this must not panic.
+	gen, err = NewGenerator(WithAlphabet("😀😁😂🤣"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.(BatchGenerator).NewN(1, 1<<62)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for an overflowing length")
+}
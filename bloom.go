@@ -0,0 +1,74 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"hash/maphash"
+	"sync"
+)
+
+// bloomFilter is a fixed-size, concurrency-safe Bloom filter of issued IDs.
+type bloomFilter struct {
+	mu   sync.Mutex
+	bits []uint64
+	m    uint64
+	k    int
+
+	// seed1 and seed2 key the two base hashes combined by double hashing.
+	seed1 maphash.Seed
+	seed2 maphash.Seed
+}
+
+// newBloomFilter creates a Bloom filter of m bits probed by k hash functions.
+func newBloomFilter(m, k int) *bloomFilter {
+	return &bloomFilter{
+		bits:  make([]uint64, (m+63)/64),
+		m:     uint64(m),
+		k:     k,
+		seed1: maphash.MakeSeed(),
+		seed2: maphash.MakeSeed(),
+	}
+}
+
+// hashes returns the two base hashes of id. The second is forced odd so that successive
+// probes h1 + i*h2 do not collapse onto the same bit.
+func (b *bloomFilter) hashes(id ID) (uint64, uint64) {
+	return maphash.String(b.seed1, string(id)), maphash.String(b.seed2, string(id)) | 1
+}
+
+// contains reports whether id has probably been added to the filter.
+func (b *bloomFilter) contains(id ID) bool {
+	h1, h2 := b.hashes(id)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.test(h1, h2)
+}
+
+// add records id in the filter.
+func (b *bloomFilter) add(id ID) {
+	h1, h2 := b.hashes(id)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i := 0; i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// test reports whether every probed bit is set. The caller must hold b.mu.
+func (b *bloomFilter) test(h1, h2 uint64) bool {
+	for i := 0; i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
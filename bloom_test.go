@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithBloomFilter tests that issued IDs are recorded in the Bloom filter.
+func TestWithBloomFilter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithBloomFilter(1<<16, 4))
+	is.NoError(err, "NewGenerator() should not return an error with a Bloom filter")
+
+	bloom := gen.(*generator).bloom
+	is.NotNil(bloom, "The generator should maintain a Bloom filter")
+
+	ids := make([]ID, 1000)
+	for i := range ids {
+		ids[i], err = gen.New(DefaultLength)
+		is.NoError(err, "New should not return an error")
+		is.True(isValidID(ids[i], DefaultAlphabet), "Generated ID contains invalid characters")
+	}
+
+	for _, id := range ids {
+		is.True(bloom.contains(id), "Issued ID %q should be flagged by the Bloom filter", id)
+		is.False(gen.(*generator).admit(id), "Re-issuing %q should be rejected", id)
+	}
+}
+
+// TestWithBloomFilterRegenerates tests that a probable repeat exhausts the retry budget.
+func TestWithBloomFilterRegenerates(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// A constant reader always yields the same candidate.
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&cyclicReader{data: []byte{0}}),
+		WithBloomFilter(1024, 3),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a Bloom filter")
+
+	id, err := gen.New(8)
+	is.NoError(err, "The first ID should be issued")
+	is.Equal(ID("AAAAAAAA"), id)
+
+	_, err = gen.New(8)
+	is.Equal(ErrExceededMaxAttempts, err, "A repeated ID should be regenerated until the budget is exhausted")
+}
+
+// TestWithBloomFilterMonotonic tests that only IDs actually returned are recorded, not
+// candidates replaced by the monotonic successor of the previous ID.
+func TestWithBloomFilterMonotonic(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// The reader yields BB, then AA, which sorts before BB and is replaced by BC.
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&cyclicReader{data: []byte{1, 1, 0, 0}}),
+		WithBloomFilter(1024, 3),
+		WithMonotonic(true),
+	)
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	for _, want := range []ID{"BB", "BC"} {
+		id, err := gen.New(2)
+		is.NoError(err, "New should not return an error")
+		is.Equal(want, id)
+	}
+
+	bloom := gen.(*generator).bloom
+	is.True(bloom.contains("BB"), "Returned ID BB should be recorded")
+	is.True(bloom.contains("BC"), "Returned ID BC should be recorded")
+	is.False(bloom.contains("AA"), "The replaced candidate AA should not be recorded")
+}
+
+// stallingReader sleeps for the configured delay and then fills p with zeros.
+type stallingReader struct {
+	delay time.Duration
+}
+
+// Read sleeps for the configured delay and then fills p with zeros.
+func (s *stallingReader) Read(p []byte) (int, error) {
+	time.Sleep(s.delay)
+	clear(p)
+	return len(p), nil
+}
+
+// TestWithBloomFilterConcurrent tests that concurrent callers cannot both issue the same ID.
+func TestWithBloomFilterConcurrent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// A stalling constant reader always yields the same candidate and keeps the callers
+	// overlapping, so only one caller may succeed.
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&stallingReader{delay: 100 * time.Microsecond}),
+		WithBloomFilter(1024, 3),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a Bloom filter")
+
+	var wg sync.WaitGroup
+	var issued atomic.Int32
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := gen.New(8); err == nil {
+				issued.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	is.Equal(int32(1), issued.Load(), "The repeated candidate should be issued exactly once")
+}
+
+// TestWithBloomFilterInvalid tests rejection of invalid Bloom filter parameters.
+func TestWithBloomFilterInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, params := range [][2]int{{0, 3}, {1024, 0}, {-1, -1}} {
+		_, err := NewGenerator(WithBloomFilter(params[0], params[1]))
+		is.Equal(ErrInvalidBloomFilter, err, "Expected ErrInvalidBloomFilter for m=%d, k=%d", params[0], params[1])
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"unicode"
+)
+
+// hasMixedCase reports whether id contains at least one uppercase and one lowercase letter.
+func hasMixedCase(id ID) bool {
+	var upper, lower bool
+	for _, r := range string(id) {
+		upper = upper || unicode.IsUpper(r)
+		lower = lower || unicode.IsLower(r)
+		if upper && lower {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithRequireMixedCase tests that every ID contains both letter cases.
+func TestWithRequireMixedCase(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Short IDs from a mostly-digit alphabet frequently lack one of the cases.
+	const alphabet = "0123456789aB"
+	gen, err := NewGenerator(WithAlphabet(alphabet), WithRequireMixedCase(true))
+	is.NoError(err, "NewGenerator() should not return an error with mixed case required")
+	is.True(gen.(Configuration).Config().(ExtendedConfig).RequiresMixedCase(), "Config.RequiresMixedCase should be true")
+
+	for i := 0; i < 1000; i++ {
+		id, err := gen.New(12)
+		if err == ErrExceededMaxAttempts {
+			continue
+		}
+		is.NoError(err, "New should not return an error")
+		is.True(isValidID(id, alphabet), "Generated ID contains invalid characters")
+		is.True(hasMixedCase(id), "ID %q should contain both upper and lower case", id)
+	}
+}
+
+// TestWithRequireMixedCaseImpossible tests that an unsatisfiable requirement exhausts the budget.
+func TestWithRequireMixedCaseImpossible(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithAlphabet("ABCDEF0123456789"), WithRequireMixedCase(true))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.New(DefaultLength)
+	is.Equal(ErrExceededMaxAttempts, err, "Expected ErrExceededMaxAttempts for a single-case alphabet")
+
+	gen, err = NewGenerator(WithRequireMixedCase(true))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.New(1)
+	is.Equal(ErrExceededMaxAttempts, err, "Expected ErrExceededMaxAttempts for a single-character ID")
+}
+
+// TestHasMixedCase tests the mixed-case predicate.
+func TestHasMixedCase(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.True(hasMixedCase("aB"))
+	is.True(hasMixedCase("12xY34"))
+	is.True(hasMixedCase("éÉ"), "Non-ASCII letters should be recognized")
+	is.False(hasMixedCase("abc"))
+	is.False(hasMixedCase("ABC"))
+	is.False(hasMixedCase("123_-"))
+	is.False(hasMixedCase(EmptyID))
+}
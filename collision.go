@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math"
+)
+
+// SafeCapacity returns how many IDs of the specified length can be generated with this
+// generator's alphabet before the probability of at least one collision reaches
+// 'collisionProbability'.
+//
+// It uses the birthday approximation n ≈ sqrt(2 · N · ln(1 / (1 − p))), where N is the number
+// of distinct IDs (alphabetLen^length). The computation is performed in log space so it does
+// not overflow for long IDs, and the result saturates at math.MaxUint64.
+//
+// Parameters:
+//   - length int: The number of random characters in each ID.
+//   - collisionProbability float64: The acceptable probability of a collision, in (0, 1).
+//
+// Returns:
+//   - uint64: The approximate number of IDs that can be generated. It is 0 if length is not
+//     positive or the probability is not in (0, 1].
+//
+// Usage Example:
+//
+//	// How many 21-character IDs before a one-in-a-billion chance of collision?
+//	n := generator.SafeCapacity(21, 1e-9)
+func (g *generator) SafeCapacity(length int, collisionProbability float64) uint64 {
+	return IDsUntilCollision(int(g.config.alphabetLen), length, collisionProbability)
+}
+
+// CollisionProbability returns the approximate probability of at least one collision after
+// generating 'count' random IDs of the given length from an alphabet of 'alphabetLen' characters.
+//
+// It uses the birthday approximation p ≈ 1 − exp(−count² / (2 · N)), where N is the number of
+// distinct IDs (alphabetLen^length), computed in log space so it does not overflow for long IDs.
+// This is a pure calculation for sizing IDs; it draws no randomness.
+//
+// Parameters:
+//   - alphabetLen int: The number of characters in the alphabet.
+//   - length int: The number of random characters in each ID.
+//   - count uint64: The number of IDs generated.
+//
+// Returns:
+//   - float64: The approximate collision probability in [0, 1]. It is 0 if alphabetLen or
+//     length is not positive, or fewer than two IDs are generated.
+//
+// Usage Example:
+//
+//	// Chance of a collision among a billion 21-character IDs from the default alphabet.
+//	p := nanoid.CollisionProbability(64, 21, 1_000_000_000)
+func CollisionProbability(alphabetLen, length int, count uint64) float64 {
+	if alphabetLen < 1 || length <= 0 || count < 2 {
+		return 0
+	}
+
+	// ln(count² / 2N) = 2 · ln(count) − ln 2 − length · ln(alphabetLen)
+	logX := 2*math.Log(float64(count)) - math.Ln2 - float64(length)*math.Log(float64(alphabetLen))
+
+	return -math.Expm1(-math.Exp(logX))
+}
+
+// IDsUntilCollision returns the approximate number of random IDs of the given length, drawn from
+// an alphabet of 'alphabetLen' characters, that can be generated before the probability of at
+// least one collision reaches 'probability'. It is the inverse of CollisionProbability.
+//
+// It uses the birthday approximation n ≈ sqrt(2 · N · ln(1 / (1 − p))), where N is the number
+// of distinct IDs (alphabetLen^length). The computation is performed in log space so it does
+// not overflow for long IDs, and the result saturates at math.MaxUint64.
+//
+// Parameters:
+//   - alphabetLen int: The number of characters in the alphabet.
+//   - length int: The number of random characters in each ID.
+//   - probability float64: The acceptable probability of a collision, in (0, 1).
+//
+// Returns:
+//   - uint64: The approximate number of IDs. It is 0 if alphabetLen or length is not positive
+//     or the probability is not in (0, 1], and math.MaxUint64 for a probability of 1.
+//
+// Usage Example:
+//
+//	// How many 21-character IDs before a one-in-a-billion chance of collision?
+//	n := nanoid.IDsUntilCollision(64, 21, 1e-9)
+func IDsUntilCollision(alphabetLen, length int, probability float64) uint64 {
+	if alphabetLen < 1 || length <= 0 || math.IsNaN(probability) || probability <= 0 {
+		return 0
+	}
+
+	if probability >= 1 {
+		return math.MaxUint64
+	}
+
+	// ln(n) = ½ · (ln 2 + length · ln(alphabetLen) + ln(−ln(1 − p)))
+	logN := 0.5 * (math.Ln2 + float64(length)*math.Log(float64(alphabetLen)) + math.Log(-math.Log1p(-probability)))
+	if logN >= 64*math.Ln2 {
+		return math.MaxUint64
+	}
+
+	return uint64(math.Exp(logN))
+}
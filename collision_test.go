@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_SafeCapacity tests the capacity estimate for the default generator.
+func TestGenerator_SafeCapacity(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	// 64^21 = 2^126 possible IDs; sqrt(2 · 2^126 · 1e-9) ≈ 4.1248e14.
+	capacity := gen.(Profiler).SafeCapacity(DefaultLength, 1e-9)
+	is.InEpsilon(4.1248e14, float64(capacity), 1e-3, "SafeCapacity should match the birthday approximation")
+
+	is.Less(gen.(Profiler).SafeCapacity(DefaultLength, 1e-12), capacity, "A stricter target should reduce capacity")
+	is.Greater(gen.(Profiler).SafeCapacity(DefaultLength+1, 1e-9), capacity, "A longer ID should increase capacity")
+
+	is.Equal(uint64(math.MaxUint64), gen.(Profiler).SafeCapacity(100, 1e-9), "Capacity should saturate for very long IDs")
+	is.Equal(uint64(math.MaxUint64), gen.(Profiler).SafeCapacity(DefaultLength, 1), "A certain collision imposes no limit")
+	is.Zero(gen.(Profiler).SafeCapacity(DefaultLength, 0), "A zero probability should yield zero capacity")
+	is.Zero(gen.(Profiler).SafeCapacity(0, 1e-9), "A non-positive length should yield zero capacity")
+}
+
+// TestCollisionProbability tests the birthday approximation against known values.
+func TestCollisionProbability(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// 365 "days" with 23 "people": the classic birthday paradox, approximately 0.5.
+	is.InDelta(0.5, CollisionProbability(365, 1, 23), 0.02, "Expected roughly even odds for 23 birthdays")
+
+	// 64^21 = 2^126; 2^60 IDs give x = 2^120 / 2^127 = 1/128.
+	is.InEpsilon(-math.Expm1(-1.0/128), CollisionProbability(64, 21, 1<<60), 1e-9, "Expected the birthday approximation")
+
+	// Long IDs do not underflow to exactly zero or overflow.
+	p := CollisionProbability(64, 100, math.MaxUint64)
+	is.GreaterOrEqual(p, 0.0)
+	is.Less(p, 1e-100, "Very long IDs should have a negligible collision probability")
+
+	is.Equal(1.0, CollisionProbability(2, 1, 1_000_000), "Exhausting the ID space should make a collision certain")
+
+	is.Zero(CollisionProbability(64, 21, 1), "A single ID cannot collide")
+	is.Zero(CollisionProbability(64, 0, 100), "A non-positive length should yield zero")
+	is.Zero(CollisionProbability(0, 21, 100), "A non-positive alphabet length should yield zero")
+}
+
+// TestIDsUntilCollision tests that IDsUntilCollision inverts CollisionProbability.
+func TestIDsUntilCollision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, target := range []float64{1e-12, 1e-9, 1e-3, 0.5} {
+		n := IDsUntilCollision(64, DefaultLength, target)
+		is.InEpsilon(target, CollisionProbability(64, DefaultLength, n), 1e-6, "Expected round-trip for p=%v", target)
+	}
+
+	is.Equal(uint64(math.MaxUint64), IDsUntilCollision(64, DefaultLength, 1), "A certain collision imposes no limit")
+	is.Zero(IDsUntilCollision(64, DefaultLength, math.NaN()), "NaN should yield zero")
+	is.Zero(IDsUntilCollision(0, DefaultLength, 1e-9), "A non-positive alphabet length should yield zero")
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"crypto/sha256"
+)
+
+// CommitmentGenerator is implemented by generators that can generate IDs together with a
+// cryptographic commitment to them.
+type CommitmentGenerator interface {
+	// NewWithCommitment generates a Nano ID of the specified length together with its SHA-256
+	// commitment, so the commitment can be published before the ID is revealed.
+	//
+	// Usage:
+	//   id, commitment, err := generator.NewWithCommitment(21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Printf("Commitment: %x\n", commitment)
+	NewWithCommitment(length int) (id ID, commitment [32]byte, err error)
+}
+
+// NewWithCommitment generates a Nano ID of the specified length together with its SHA-256
+// commitment, for use in commit-reveal schemes.
+//
+// A party can publish the commitment up front and reveal the ID later; anyone can then confirm
+// that sha256.Sum256([]byte(id)) matches the published commitment. The ID must be long enough
+// to resist brute-force preimage search, so the default length of 21 or more is recommended.
+//
+// Parameters:
+//   - length int: The desired length of the ID.
+//
+// Returns:
+//   - ID: The generated ID, to be kept secret until reveal.
+//   - [32]byte: The SHA-256 hash of the ID's bytes.
+//   - error: An error object if generation fails.
+//
+// Usage:
+//
+//	id, commitment, err := generator.NewWithCommitment(21)
+//	if err != nil {
+//	    // handle error
+//	}
+//	publish(commitment)
+//	// ... later
+//	reveal(id)
+func (g *generator) NewWithCommitment(length int) (id ID, commitment [32]byte, err error) {
+	id, err = g.New(length)
+	if err != nil {
+		return EmptyID, commitment, err
+	}
+
+	return id, sha256.Sum256([]byte(id)), nil
+}
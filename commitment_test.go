@@ -0,0 +1,29 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewWithCommitment tests that the commitment is the SHA-256 hash of the ID.
+func TestNewWithCommitment(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id, commitment, err := Generator.(CommitmentGenerator).NewWithCommitment(DefaultLength)
+	is.NoError(err, "NewWithCommitment should not return an error")
+	is.Len(id, DefaultLength, "Generated ID should have the requested length")
+	is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+	is.Equal(sha256.Sum256([]byte(id)), commitment, "Commitment should be the SHA-256 hash of the ID")
+
+	_, commitment, err = Generator.(CommitmentGenerator).NewWithCommitment(0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for zero length")
+	is.Equal([32]byte{}, commitment, "Commitment should be zero on error")
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"math"
 	"math/bits"
+	"slices"
 	"unicode"
 	"unicode/utf8"
 )
@@ -27,6 +28,93 @@ type ConfigOptions struct {
 
 	// LengthHint specifies a typical or default length for generated IDs.
 	LengthHint uint16
+
+	// AlphabetDedup, when true, removes duplicate characters from the alphabet (keeping the
+	// first occurrence) instead of failing with ErrDuplicateCharacters.
+	AlphabetDedup bool
+
+	// BloomFilterBits is the size in bits of the Bloom filter of issued IDs.
+	// Zero disables the filter.
+	BloomFilterBits int
+
+	// BloomFilterHashes is the number of hash functions probed per ID in the Bloom filter.
+	BloomFilterHashes int
+
+	// HybridRandomPrefix, when positive, is the number of random characters that precede a
+	// millisecond timestamp suffix in every ID. Zero disables the hybrid layout.
+	HybridRandomPrefix int
+
+	// LeadingAlphabet, when non-empty, is the set of characters used for the first character
+	// of every generated ID, while Alphabet is used for the remaining characters.
+	// It is subject to the same validation rules as Alphabet.
+	LeadingAlphabet string
+
+	// NoConsecutiveRepeats, when true, ensures no two adjacent random characters of an ID are identical.
+	NoConsecutiveRepeats bool
+
+	// TimePrefixBytes, when positive, is the number of low-order bytes of the Unix millisecond
+	// timestamp encoded at the start of every ID's body, making IDs sortable by creation time.
+	// Zero disables the time prefix.
+	TimePrefixBytes int
+
+	// Version is the schema version encoded by the character following the prefix,
+	// when Versioned is true.
+	Version byte
+
+	// Versioned, when true, encodes Version as a character at the start of every ID.
+	Versioned bool
+
+	// Prefix, when non-empty, is a fixed string prepended to every generated ID, such as "usr_".
+	// Unlike RegionCode, its characters need not belong to the alphabet.
+	Prefix string
+
+	// MaxAttempts bounds rejection sampling: New draws at most MaxAttempts random values per
+	// character (counting IDs shorter than four characters as four), and regenerates a rejected
+	// candidate at most MaxAttempts times, before failing with ErrExceededMaxAttempts. It defaults
+	// to 10 and must be positive.
+	MaxAttempts int
+
+	// MinEntropy, when positive, is the number of bits of entropy every ID must carry. The
+	// generator derives the corresponding length from the alphabet size and raises LengthHint
+	// and MinLength to it. Zero disables the requirement.
+	MinEntropy float64
+
+	// MinLength is the shortest ID length that New will generate.
+	// Requests for shorter IDs fail with ErrLengthTooShort. It defaults to 1.
+	MinLength int
+
+	// LengthPrefix, when true, encodes the length of each ID's random body in a leading character.
+	LengthPrefix bool
+
+	// Monotonic, when true, guarantees that each ID sorts strictly after the previous ID of the
+	// same length issued by the same generator instance.
+	Monotonic bool
+
+	// RecentBufferSize, when positive, is the number of most recently issued IDs that a new ID
+	// must differ from. Zero disables the check.
+	RecentBufferSize int
+
+	// RequireMixedCase, when true, rejects IDs that lack either an uppercase or a lowercase letter.
+	RequireMixedCase bool
+
+	// Suffix, when non-empty, is a fixed string appended to every generated ID.
+	// Its characters need not belong to the alphabet.
+	Suffix string
+
+	// RegionCode, when non-empty, is a fixed region or datacenter code prepended to every
+	// generated ID. Every character of the code must belong to the alphabet.
+	RegionCode string
+
+	// ZeroPadding is the default width to which EncodeUint64 left-pads its result with the
+	// alphabet's first character. Zero disables padding.
+	ZeroPadding int
+
+	// maxLength is an internal upper bound on the requested ID length. Zero means unbounded.
+	maxLength int
+
+	// filters are internal predicates that every generated ID must satisfy.
+	// Candidates rejected by a filter are regenerated within the attempt budget.
+	filters []func(ID) bool
 }
 
 // Config holds the runtime configuration for the Nano ID generator.
@@ -74,11 +162,31 @@ type Config interface {
 	// It rounds up BitsNeeded to the nearest byte, ensuring sufficient space for random data generation.
 	BytesNeeded() uint
 
+	// Indices returns the position within the alphabet of each character in the ID.
+	//
+	// It returns ErrInvalidCharacter if any character is not part of the alphabet.
+	// This is a building block for decoders and validation tooling.
+	Indices(id ID) ([]int, error)
+
 	// IsASCII returns true if the alphabet consists solely of ASCII characters.
 	//
 	// This allows for optimization in processing, using bytes instead of runes for ID generation.
 	IsASCII() bool
 
+	// IsDNSLabelSafe returns true if the alphabet consists solely of lowercase ASCII letters and
+	// digits, so every ID of up to 63 characters is a valid DNS label.
+	//
+	// Hyphens are valid inside DNS labels but not at either end, so alphabets containing
+	// them are not considered safe.
+	IsDNSLabelSafe() bool
+
+	// IsFilesystemSafe returns true if no character in the alphabet is forbidden in file names
+	// on Windows, macOS or Linux.
+	//
+	// This checks characters only; reserved names such as "CON" and case-insensitive filesystems
+	// are handled by NewFilesystemSafeGenerator.
+	IsFilesystemSafe() bool
+
 	// IsPowerOfTwo returns true if the length of the alphabet is a power of two.
 	//
 	// When true, random index selection can be optimized using bitwise operations,
@@ -110,11 +218,19 @@ type Config interface {
 	// ensuring uniform distribution and preventing bias.
 	Mask() uint
 
+	// Prefix returns the fixed string prepended to every generated ID,
+	// or an empty string if none is configured.
+	Prefix() string
+
 	// RandReader returns the source of randomness used for generating IDs.
 	//
 	// It is typically a cryptographically secure random number generator (e.g., crypto/rand.Reader).
 	RandReader() io.Reader
 
+	// Suffix returns the fixed string appended to every generated ID,
+	// or an empty string if none is configured.
+	Suffix() string
+
 	// RuneAlphabet returns the slice of runes representing the alphabet.
 	//
 	// This is used for ID generation when the alphabet includes non-ASCII (multibyte) characters,
@@ -128,6 +244,63 @@ type Config interface {
 	ScalingFactor() int
 }
 
+// ExtendedConfig is implemented by configurations that also expose the optional ID layout and
+// generation policy settings, such as the minimum length, version and time prefix.
+type ExtendedConfig interface {
+	Config
+
+	// EntropyLength returns the minimum ID length derived from WithMinEntropy,
+	// or zero if no entropy requirement is configured.
+	//
+	// LengthHint and MinLength are at least this value.
+	EntropyLength() int
+
+	// HasLengthPrefix returns true if each ID begins with a character encoding the length of its body.
+	HasLengthPrefix() bool
+
+	// HybridRandomPrefix returns the number of random characters preceding the timestamp suffix
+	// in the hybrid layout, or zero if the layout is not enabled.
+	HybridRandomPrefix() int
+
+	// HasVersion returns true if every ID carries a version character.
+	HasVersion() bool
+
+	// IsMonotonic returns true if successive IDs of the same length are guaranteed to
+	// strictly increase in lexicographic order.
+	IsMonotonic() bool
+
+	// MinLength returns the shortest ID length the generator will produce.
+	//
+	// Requests for shorter IDs are rejected with ErrLengthTooShort.
+	MinLength() int
+
+	// MaxAttempts returns the attempt budget for rejection sampling and candidate regeneration.
+	//
+	// Generation fails with ErrExceededMaxAttempts once the budget is exhausted.
+	MaxAttempts() int
+
+	// NoConsecutiveRepeats returns true if no two adjacent random characters of an ID may be identical.
+	NoConsecutiveRepeats() bool
+
+	// RegionCode returns the fixed region code prepended to every generated ID,
+	// or an empty string if none is configured.
+	RegionCode() string
+
+	// RequiresMixedCase returns true if every ID must contain both an uppercase and a lowercase letter.
+	RequiresMixedCase() bool
+
+	// TimePrefixWidth returns the number of characters holding the creation timestamp at the
+	// start of every ID's body, or zero if the time prefix is not enabled.
+	TimePrefixWidth() int
+
+	// Version returns the schema version encoded in every ID, if HasVersion is true.
+	Version() byte
+
+	// ZeroPadding returns the default width to which EncodeUint64 left-pads its result,
+	// or zero if padding is disabled.
+	ZeroPadding() int
+}
+
 // Configuration defines the interface for retrieving generator configuration.
 type Configuration interface {
 	// Config returns the runtime configuration of the generator.
@@ -160,6 +333,535 @@ func WithAlphabet(alphabet string) Option {
 	}
 }
 
+// WithUnicodeRange sets the alphabet to the assigned, printable runes within the inclusive
+// range [start, end] that belong to the given Unicode category (for example "L", "Lu", or "Nd").
+// Unassigned code points, control characters, and runes outside the category are skipped,
+// which produces a robust alphabet from a block that may contain gaps.
+//
+// An empty category accepts every assigned, printable rune in the range. The range is clamped to
+// valid code points, [0, unicode.MaxRune]. An unknown category or an inverted range results in an
+// empty alphabet, which NewGenerator rejects with
+// ErrInvalidAlphabet. The resulting alphabet is subject to the usual length bounds
+// (MinAlphabetLength and MaxAlphabetLength).
+//
+// Parameters:
+//   - start rune: The first code point of the range.
+//   - end rune: The last code point of the range (inclusive).
+//   - category string: The name of a Unicode category from unicode.Categories, or "" for any.
+//
+// Returns:
+//   - Option: A configuration option that applies the derived alphabet to ConfigOptions.
+//
+// Usage:
+//
+//	// Greek letters only
+//	generator, err := nanoid.NewGenerator(nanoid.WithUnicodeRange(0x0370, 0x03FF, "L"))
+func WithUnicodeRange(start, end rune, category string) Option {
+	return func(c *ConfigOptions) {
+		c.Alphabet = unicodeRangeAlphabet(start, end, category)
+	}
+}
+
+// unicodeRangeAlphabet builds an alphabet from the assigned, printable runes in [start, end]
+// matching the named Unicode category.
+func unicodeRangeAlphabet(start, end rune, category string) string {
+	var table *unicode.RangeTable
+	if category != "" {
+		var ok bool
+		if table, ok = unicode.Categories[category]; !ok {
+			return ""
+		}
+	}
+
+	// Clamp the range to valid code points, so that an out-of-range bound does not iterate
+	// through billions of invalid values.
+	start = max(start, 0)
+	end = min(end, unicode.MaxRune)
+
+	var runes []rune
+	for r := start; r <= end; r++ {
+		if !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			continue
+		}
+		if table != nil && !unicode.Is(table, r) {
+			continue
+		}
+		runes = append(runes, r)
+
+		// One rune more than the maximum is enough for NewGenerator to reject the alphabet.
+		if len(runes) > MaxAlphabetLength {
+			break
+		}
+	}
+
+	return string(runes)
+}
+
+// WithAlphabetDedup controls how duplicate characters in the alphabet are handled.
+// When enabled, duplicates are silently removed, keeping the first occurrence of each
+// character, which is convenient for alphabets assembled programmatically. When disabled
+// (the default), an alphabet containing duplicates is rejected with ErrDuplicateCharacters.
+//
+// Parameters:
+//   - dedup bool: Whether to remove duplicate characters instead of returning an error.
+//
+// Returns:
+//   - Option: A configuration option that applies the deduplication setting to ConfigOptions.
+//
+// Usage:
+//
+//	// Produces a generator with the 3-character alphabet "abc".
+//	generator, err := nanoid.NewGenerator(
+//	    nanoid.WithAlphabet("aabbcc"),
+//	    nanoid.WithAlphabetDedup(true))
+func WithAlphabetDedup(dedup bool) Option {
+	return func(c *ConfigOptions) {
+		c.AlphabetDedup = dedup
+	}
+}
+
+// WithBloomFilter maintains a Bloom filter of every ID the generator issues, regenerating any
+// candidate the filter reports as probably issued before.
+//
+// This offers probabilistic uniqueness within a single generator instance using a fixed m bits
+// of memory, no matter how many IDs are issued. A Bloom filter never misses an issued ID, but it
+// does report false positives: some IDs that were never issued are treated as repeats and
+// regenerated. As the filter fills, false positives and therefore regenerations become more
+// frequent, until New eventually fails with ErrExceededMaxAttempts. Size m generously; with n
+// expected IDs, the false-positive rate is roughly (1 - e^(-kn/m))^k, minimized near k = (m/n)·ln 2.
+//
+// Parameters:
+//   - m int: The number of bits in the filter. Must be at least 1.
+//   - k int: The number of hash functions probed per ID. Must be at least 1.
+//
+// Returns:
+//   - Option: A configuration option that applies the Bloom filter settings to ConfigOptions.
+//
+// Usage Example:
+//
+//	// Roughly 1% false positives after one million IDs.
+//	generator, err := nanoid.NewGenerator(nanoid.WithBloomFilter(9_600_000, 7))
+func WithBloomFilter(m, k int) Option {
+	return func(c *ConfigOptions) {
+		c.BloomFilterBits = m
+		c.BloomFilterHashes = k
+	}
+}
+
+// WithHybridLayout produces IDs made of randomPrefix random characters followed by a suffix
+// encoding the generation time, so IDs are unpredictable yet carry a recoverable timestamp.
+//
+// The length passed to New is the total number of characters. The suffix fills the characters
+// after the random prefix with the Unix time in milliseconds, written in the alphabet's base and
+// left-padded with the alphabet's first character; it needs at least ceil(48 / log2(alphabetLen))
+// characters (8 for the default alphabet), and shorter requests fail with ErrLengthTooShort.
+// TimeWindow recovers the timestamp.
+//
+// Because the random characters come first, IDs do not sort by time. Time filtering and ordering
+// should use TimeWindow, or compare the suffixes of IDs with the same length and random prefix
+// width when the alphabet is in code point order.
+//
+// Parameters:
+//   - randomPrefix int: The number of random characters before the timestamp. Must not be negative.
+//
+// Returns:
+//   - Option: A configuration option that applies the hybrid layout to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithHybridLayout(13))
+//	id, err := generator.New(21) // 13 random characters followed by an 8-character timestamp.
+func WithHybridLayout(randomPrefix int) Option {
+	return func(c *ConfigOptions) {
+		c.HybridRandomPrefix = randomPrefix
+	}
+}
+
+// WithLeadingAlphabet sets a separate alphabet for the first character of every generated ID.
+// The remaining characters continue to be drawn from the configured alphabet. This is useful
+// for formats that restrict the leading character, such as identifiers that must not start
+// with a digit.
+//
+// Parameters:
+//   - alphabet string: The set of characters permitted in the first position.
+//
+// Returns:
+//   - Option: A configuration option that applies the leading alphabet to ConfigOptions.
+//
+// Usage:
+//
+//	generator, err := nanoid.NewGenerator(
+//	    nanoid.WithAlphabet("abcdefghijklmnopqrstuvwxyz0123456789"),
+//	    nanoid.WithLeadingAlphabet("abcdefghijklmnopqrstuvwxyz"))
+func WithLeadingAlphabet(alphabet string) Option {
+	return func(c *ConfigOptions) {
+		c.LeadingAlphabet = alphabet
+	}
+}
+
+// WithMinEntropy requires every ID to carry at least the given number of bits of entropy,
+// deriving the length from the alphabet so callers can reason about collision resistance
+// instead of character counts.
+//
+// The derived length is ceil(bits / log2(alphabetLen)), for example 22 characters for 128 bits
+// with the default alphabet. It raises both the length hint and the minimum length to that value,
+// so the larger of the derived length and any WithLengthHint value wins, and New rejects shorter
+// requests with ErrLengthTooShort. Config.EntropyLength reports the derived length.
+//
+// Parameters:
+//   - bits float64: The minimum number of bits of entropy. Must be a positive, finite number.
+//
+// Returns:
+//   - Option: A configuration option that applies the entropy requirement to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithMinEntropy(128))
+//	length := generator.(nanoid.Configuration).Config().EntropyLength() // 22
+//	id, err := generator.New(length)
+func WithMinEntropy(bits float64) Option {
+	return func(c *ConfigOptions) {
+		c.MinEntropy = bits
+	}
+}
+
+// WithMinLength sets the shortest ID length the Interface will generate.
+// Calls to New with a length below the minimum return ErrLengthTooShort, which enforces
+// a policy floor such as "never generate an ID shorter than 16 characters".
+//
+// Parameters:
+//   - n int: The minimum permitted ID length. It must be at least 1.
+//
+// Returns:
+//   - Option: A configuration option that applies the minimum length to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithMinLength(16))
+func WithMinLength(n int) Option {
+	return func(c *ConfigOptions) {
+		c.MinLength = n
+	}
+}
+
+// WithMaxAttempts sets the attempt budget for rejection sampling, replacing the default of 10.
+//
+// Characters are drawn by rejection sampling, so with a non-power-of-two alphabet some random
+// values are discarded. The generator reads at most n random values per requested character, with
+// IDs shorter than four characters budgeted as four so that very short IDs are not starved, and
+// regenerates a candidate rejected by a filter or constraint (such as a leading alphabet or
+// WithBloomFilter) at most n times, before returning ErrExceededMaxAttempts. Raising the budget
+// makes that error less likely with small alphabets or custom random readers, at the cost of more
+// work before a persistently failing reader is reported.
+//
+// Parameters:
+//   - n int: The attempt budget. It must be at least 1.
+//
+// Returns:
+//   - Option: A configuration option that applies the attempt budget to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(
+//	    nanoid.WithAlphabet("ABC"),
+//	    nanoid.WithMaxAttempts(100))
+func WithMaxAttempts(n int) Option {
+	return func(c *ConfigOptions) {
+		c.MaxAttempts = n
+	}
+}
+
+// WithLengthPrefix prepends a character encoding the length of the random body to every ID,
+// so that IDs of varying lengths are self-describing and LengthOf can recover the length from
+// the ID itself.
+//
+// The length is written as a single digit in the alphabet's base: a body of length n is prefixed
+// with the alphabet's character at index n. The longest encodable body is therefore one less than
+// the alphabet length (63 characters for the default alphabet); longer requests fail with
+// ErrInvalidLength. The prefix follows the region code, if one is configured, and is not counted
+// in the requested length.
+//
+// Parameters:
+//   - lengthPrefix bool: Whether to prepend the length character.
+//
+// Returns:
+//   - Option: A configuration option that applies the length prefix setting to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithLengthPrefix(true))
+//	id, err := generator.New(12) // 13 characters: the length character followed by 12 random characters.
+func WithLengthPrefix(lengthPrefix bool) Option {
+	return func(c *ConfigOptions) {
+		c.LengthPrefix = lengthPrefix
+	}
+}
+
+// WithMonotonic makes every ID sort strictly after the previous ID of the same length issued
+// by the generator instance, which suits append-only logs and ordered keys.
+//
+// Each ID starts as a fresh random candidate. When the candidate does not sort after the previous
+// ID, the previous ID is incremented by one instead, treating characters as digits in code point
+// order, and because the sequence only moves upward it may eventually be exhausted
+// (ErrMonotonicOverflow).
+//
+// Without WithTimePrefix, the previous ID only grows, so a random candidate soon almost never
+// sorts after it: after the first few hundred IDs, nearly every ID is simply the previous ID plus
+// one. Such a generator is effectively an enumerable counter, and its IDs must not be treated as
+// unguessable. Combine WithMonotonic with WithTimePrefix, under which a candidate from a later
+// millisecond always sorts after the previous ID, so only IDs within one millisecond are
+// incremented.
+//
+// Ordering is only guaranteed between IDs of the same length generated by the same instance; the
+// internal state is guarded by a mutex, so monotonic generators remain safe for concurrent use.
+//
+// Parameters:
+//   - monotonic bool: Whether to enforce strictly increasing IDs.
+//
+// Returns:
+//   - Option: A configuration option that applies the monotonic setting to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithMonotonic(true))
+func WithMonotonic(monotonic bool) Option {
+	return func(c *ConfigOptions) {
+		c.Monotonic = monotonic
+	}
+}
+
+// WithRecentBuffer keeps the last 'size' issued IDs in a ring buffer and regenerates any candidate
+// that matches one of them, which prevents immediate repeats, such as showing the same code twice
+// in a row, without the unbounded memory of full uniqueness tracking.
+//
+// Only the most recent IDs are checked, so older IDs may recur. Each check scans the ring, so it
+// is intended for small sizes; for large histories use WithBloomFilter instead. The check applies
+// to the random characters of an ID, before decorations such as a prefix are added. If every
+// candidate within the attempt budget is in the ring, New returns ErrExceededMaxAttempts.
+//
+// Parameters:
+//   - size int: The number of recent IDs to remember. Must not be negative; zero disables the buffer.
+//
+// Returns:
+//   - Option: A configuration option that applies the buffer size to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithRecentBuffer(16))
+func WithRecentBuffer(size int) Option {
+	return func(c *ConfigOptions) {
+		c.RecentBufferSize = size
+	}
+}
+
+// WithRequireMixedCase requires every ID to contain at least one uppercase and one lowercase
+// letter, as some code formats demand.
+//
+// Candidates lacking either case are regenerated within the attempt budget. If the alphabet
+// cannot satisfy the requirement, for example because it has no lowercase letters or the
+// requested length is 1, New returns ErrExceededMaxAttempts. Letter case is determined with
+// unicode.IsUpper and unicode.IsLower, so non-ASCII alphabets are supported.
+//
+// Parameters:
+//   - require bool: Whether to require both letter cases.
+//
+// Returns:
+//   - Option: A configuration option that applies the mixed-case requirement to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithRequireMixedCase(true))
+func WithRequireMixedCase(require bool) Option {
+	return func(c *ConfigOptions) {
+		c.RequireMixedCase = require
+	}
+}
+
+// WithNoConsecutiveRepeats ensures that no two adjacent random characters of an ID are identical,
+// which suits human-facing codes such as vouchers, where doubled characters look like typos.
+//
+// A character equal to its predecessor is re-sampled from the alphabet, and candidates that still
+// contain a repeat are regenerated within the attempt budget. This reduces the entropy of each
+// character after the first from log2(n) to log2(n − 1) bits for an alphabet of n characters.
+// For tiny alphabets the constraint may be impractical: with two characters every ID alternates
+// between them, and New may return ErrExceededMaxAttempts. Only the random characters are
+// constrained; region codes, prefixes and timestamps are not.
+//
+// Parameters:
+//   - noRepeats bool: Whether to forbid adjacent identical characters.
+//
+// Returns:
+//   - Option: A configuration option that applies the setting to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(
+//	    nanoid.WithAlphabet("ABCDEFGHJKLMNPQRSTUVWXYZ23456789"),
+//	    nanoid.WithNoConsecutiveRepeats(true))
+func WithNoConsecutiveRepeats(noRepeats bool) Option {
+	return func(c *ConfigOptions) {
+		c.NoConsecutiveRepeats = noRepeats
+	}
+}
+
+// WithTimePrefix starts the body of every ID with its creation time, so that IDs generated in
+// sequence sort in time order, which keeps B-tree inserts local when IDs are primary keys.
+//
+// The low-order 'bytes' bytes of the Unix time in milliseconds are written big-endian in the
+// alphabet's base, taking ceil(8 · bytes / log2(alphabetLen)) characters, followed by random
+// characters. Six bytes cover dates until the year 10889; fewer bytes wrap around sooner (four
+// bytes every 49.7 days), after which ordering restarts. The length passed to New is the total
+// number of characters including the timestamp, and must leave room for at least one random
+// character.
+//
+// So that the timestamp sorts correctly under byte-wise comparison (ID.Compare) for any alphabet,
+// its digits are the alphabet's characters in code point order rather than in the order given.
+// Ordering holds between IDs of the same length and decorations, at millisecond resolution; IDs
+// created within the same millisecond are ordered randomly unless WithMonotonic is also set.
+// The time prefix cannot be combined with WithHybridLayout (ErrIncompatibleOptions).
+//
+// Parameters:
+//   - bytes int: The number of timestamp bytes to encode, from 1 to 8. Zero disables the prefix.
+//
+// Returns:
+//   - Option: A configuration option that applies the time prefix to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithTimePrefix(6))
+//	id, err := generator.New(21) // An 8-character timestamp followed by 13 random characters.
+func WithTimePrefix(bytes int) Option {
+	return func(c *ConfigOptions) {
+		c.TimePrefixBytes = bytes
+	}
+}
+
+// WithVersion encodes a schema version as a character at the start of every ID, so that parsers
+// can dispatch on the version of an ID scheme with VersionOf as the scheme evolves.
+//
+// Version v is written as the alphabet's character at index v, so the highest usable version is
+// one less than the alphabet length (63 for the default alphabet); larger versions fail with
+// ErrInvalidVersion. The version character follows the prefix, if one is configured, and precedes
+// the region code. It is not counted in the requested length.
+//
+// Parameters:
+//   - v byte: The version to encode.
+//
+// Returns:
+//   - Option: A configuration option that applies the version to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithVersion(2))
+//	id, err := generator.New(21) // "2" followed by 21 random characters.
+func WithVersion(v byte) Option {
+	return func(c *ConfigOptions) {
+		c.Version = v
+		c.Versioned = true
+	}
+}
+
+// WithPrefix prepends a fixed string to every generated ID, which namespaces IDs by type,
+// for example "usr_" or "ord_".
+//
+// The prefix is not counted in the requested length, so New(21) still yields 21 random characters,
+// and its characters need not belong to the alphabet. It is the outermost component of an ID,
+// preceding any region code and length prefix.
+//
+// Parameters:
+//   - prefix string: The string to prepend. It must be valid UTF-8.
+//
+// Returns:
+//   - Option: A configuration option that applies the prefix to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithPrefix("usr_"))
+//	id, err := generator.New(21) // "usr_" followed by 21 random characters.
+func WithPrefix(prefix string) Option {
+	return func(c *ConfigOptions) {
+		c.Prefix = prefix
+	}
+}
+
+// WithSuffix appends a fixed string to every generated ID.
+//
+// The suffix is not counted in the requested length, and its characters need not belong to
+// the alphabet. It is the last component of an ID, following any hybrid timestamp.
+//
+// Parameters:
+//   - suffix string: The string to append. It must be valid UTF-8.
+//
+// Returns:
+//   - Option: A configuration option that applies the suffix to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithSuffix(".tmp"))
+func WithSuffix(suffix string) Option {
+	return func(c *ConfigOptions) {
+		c.Suffix = suffix
+	}
+}
+
+// WithRegionCode prepends a fixed region or datacenter code to every generated ID.
+// Unlike a free-form prefix, the code must consist of characters from the alphabet and is
+// fixed-width, so it can be recovered from any ID with RegionOf. All generators sharing an
+// ID scheme should use codes of the same width.
+//
+// Parameters:
+//   - code string: The region code, for example "eu1". It must only contain alphabet characters.
+//
+// Returns:
+//   - Option: A configuration option that applies the region code to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(nanoid.WithRegionCode("eu1"))
+func WithRegionCode(code string) Option {
+	return func(c *ConfigOptions) {
+		c.RegionCode = code
+	}
+}
+
+// WithZeroPadding sets the default width to which EncodeUint64 left-pads encoded integers with the
+// alphabet's first character, which gives fixed-width output such as "000042" for a numeric alphabet.
+//
+// Unlike the minLength argument of EncodeUint64, which applies to a single call, the padding width
+// is a generator-level default; EncodeUint64 pads to whichever of the two is larger. Values too large
+// to fit in the width are not truncated.
+//
+// Parameters:
+//   - width int: The padding width in characters. Must not be negative; zero disables padding.
+//
+// Returns:
+//   - Option: A configuration option that applies the padding width to ConfigOptions.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(
+//	    nanoid.WithAlphabet("0123456789"),
+//	    nanoid.WithZeroPadding(8))
+func WithZeroPadding(width int) Option {
+	return func(c *ConfigOptions) {
+		c.ZeroPadding = width
+	}
+}
+
+// withMaxLength sets an internal upper bound on the requested ID length.
+func withMaxLength(maxLength int) Option {
+	return func(c *ConfigOptions) {
+		c.maxLength = maxLength
+	}
+}
+
+// withFilter adds an internal predicate that every generated ID must satisfy.
+func withFilter(filter func(ID) bool) Option {
+	return func(c *ConfigOptions) {
+		c.filters = append(c.filters, filter)
+	}
+}
+
 // WithRandReader sets a custom random reader for the Interface.
 // By default, the Interface uses a cryptographically secure random number
 // generator (e.g., crypto/rand.Reader). However, in some cases, users might
@@ -207,21 +909,43 @@ func WithLengthHint(hint uint16) Option {
 // runtimeConfig holds the runtime configuration for the Nano ID generator.
 // It is immutable after initialization.
 type runtimeConfig struct {
-	randReader       io.Reader // 16 bytes
-	byteAlphabet     []byte    // 24 bytes
-	runeAlphabet     []rune    // 24 bytes
-	mask             uint      // 8 bytes
-	bitsNeeded       uint      // 8 bytes
-	bytesNeeded      uint      // 8 bytes
-	bufferSize       int       // 8 bytes
-	bufferMultiplier int       // 8 bytes
-	scalingFactor    int       // 8 bytes
-	baseMultiplier   int       // 8 bytes
-	maxBytesPerRune  int       // 8 bytes
-	alphabetLen      uint16    // 2 bytes
-	lengthHint       uint16    // 2 bytes
-	isASCII          bool      // 1 byte
-	isPowerOfTwo     bool      // 1 byte
+	randReader       io.Reader    // 16 bytes
+	regionCode       string       // 16 bytes
+	prefix           string       // 16 bytes
+	suffix           string       // 16 bytes
+	byteAlphabet     []byte       // 24 bytes
+	runeAlphabet     []rune       // 24 bytes
+	runeIndex        map[rune]int // 8 bytes
+	mask             uint         // 8 bytes
+	bitsNeeded       uint         // 8 bytes
+	bytesNeeded      uint         // 8 bytes
+	bufferSize       int          // 8 bytes
+	bufferMultiplier int          // 8 bytes
+	scalingFactor    int          // 8 bytes
+	baseMultiplier   int          // 8 bytes
+	maxBytesPerRune  int          // 8 bytes
+	minLength        int          // 8 bytes
+	maxAttempts      int          // 8 bytes
+	entropyLength    int          // 8 bytes
+	hybridPrefix     int          // 8 bytes
+	hybridTimeWidth  int          // 8 bytes
+	maxLength        int          // 8 bytes
+	zeroPadding      int          // 8 bytes
+	timePrefixBytes  int          // 8 bytes
+	timePrefixWidth  int          // 8 bytes
+	sortedAlphabet   []rune       // 24 bytes
+	alphabetLen      uint16       // 2 bytes
+	lengthHint       uint16       // 2 bytes
+	isASCII          bool         // 1 byte
+	isMonotonic      bool         // 1 byte
+	isLengthPrefixed bool         // 1 byte
+	isMixedCase      bool         // 1 byte
+	isNoRepeats      bool         // 1 byte
+	isVersioned      bool         // 1 byte
+	version          byte         // 1 byte
+	isFilesystemSafe bool         // 1 byte
+	isDNSLabelSafe   bool         // 1 byte
+	isPowerOfTwo     bool         // 1 byte
 }
 
 func buildRuntimeConfig(opts *ConfigOptions) (*runtimeConfig, error) {
@@ -235,6 +959,10 @@ func buildRuntimeConfig(opts *ConfigOptions) (*runtimeConfig, error) {
 	}
 
 	alphabetRunes := []rune(opts.Alphabet)
+	if opts.AlphabetDedup {
+		alphabetRunes = dedupRunes(alphabetRunes)
+	}
+
 	isASCII := true
 	byteAlphabet := make([]byte, len(alphabetRunes))
 	maxBytesPerRune := 1 // Initialize to 1 for ASCII
@@ -261,12 +989,12 @@ func buildRuntimeConfig(opts *ConfigOptions) (*runtimeConfig, error) {
 	}
 
 	// Check for duplicate characters
-	seenRunes := make(map[rune]bool)
-	for _, r := range alphabetRunes {
-		if seenRunes[r] {
+	runeIndex := make(map[rune]int, len(alphabetRunes))
+	for i, r := range alphabetRunes {
+		if _, ok := runeIndex[r]; ok {
 			return nil, ErrDuplicateCharacters
 		}
-		seenRunes[r] = true
+		runeIndex[r] = i
 	}
 
 	// The length of the alphabet, representing the number of unique characters available for ID generation.
@@ -281,6 +1009,27 @@ func buildRuntimeConfig(opts *ConfigOptions) (*runtimeConfig, error) {
 		return nil, ErrAlphabetTooShort
 	}
 
+	// Derive the length that satisfies the entropy requirement, if any, and let it raise
+	// the length hint and minimum length.
+	lengthHint := opts.LengthHint
+	minLength := opts.MinLength
+	var entropyLength int
+	if opts.MinEntropy != 0 {
+		n, err := lengthForEntropy(opts.MinEntropy, int(alphabetLen))
+		if err != nil {
+			return nil, err
+		}
+
+		// The derived length must be one the generator can actually produce.
+		if (opts.maxLength > 0 && n > opts.maxLength) || (opts.LengthPrefix && n >= int(alphabetLen)) {
+			return nil, ErrInvalidEntropy
+		}
+
+		entropyLength = n
+		lengthHint = max(lengthHint, uint16(n))
+		minLength = max(minLength, n)
+	}
+
 	// Calculate the minimum number of bits needed to represent all indices of the alphabet.
 	// This is essential for generating random numbers that map uniformly to the alphabet indices.
 	// The calculation uses bits.Len to find the position of the highest set bit in alphabetLen - 1.
@@ -306,12 +1055,12 @@ func buildRuntimeConfig(opts *ConfigOptions) (*runtimeConfig, error) {
 	// Calculate a base multiplier for buffer size based on the length hint.
 	// The length hint indicates the desired length of the generated IDs.
 	// Using logarithm ensures the buffer scales appropriately with the ID length.
-	baseMultiplier := int(math.Ceil(math.Log2(float64(opts.LengthHint) + 2.0)))
+	baseMultiplier := int(math.Ceil(math.Log2(float64(lengthHint) + 2.0)))
 
 	// Determine a scaling factor to adjust the buffer size.
 	// This factor ensures the buffer is sufficiently large to accommodate the randomness needed,
 	// balancing between performance (less frequent random reads) and memory usage.
-	scalingFactor := int(math.Max(3.0, float64(alphabetLen)/math.Pow(float64(opts.LengthHint), 0.6)))
+	scalingFactor := int(math.Max(3.0, float64(alphabetLen)/math.Pow(float64(lengthHint), 0.6)))
 
 	// Compute the buffer multiplier by adding the base multiplier and a fraction of the scaling factor.
 	// This combination fine-tunes the buffer size, considering both the ID length and the alphabet size.
@@ -321,12 +1070,85 @@ func buildRuntimeConfig(opts *ConfigOptions) (*runtimeConfig, error) {
 	// The buffer size is influenced by the buffer multiplier, bytes needed per character,
 	// and a factor that scales with the length hint.
 	// A larger buffer reduces the number of calls to the random number generator, improving efficiency.
-	bufferSize := bufferMultiplier * int(bytesNeeded) * int(math.Max(1.5, float64(opts.LengthHint)/10.0))
+	bufferSize := bufferMultiplier * int(bytesNeeded) * int(math.Max(1.5, float64(lengthHint)/10.0))
+
+	// Ensure the region code, if any, is drawn from the alphabet so it can be parsed back.
+	for _, r := range opts.RegionCode {
+		if _, ok := runeIndex[r]; !ok {
+			return nil, ErrInvalidRegionCode
+		}
+	}
+
+	// The time prefix holds up to a full 64-bit timestamp, written with the alphabet in code point order.
+	if opts.TimePrefixBytes < 0 || opts.TimePrefixBytes > 8 {
+		return nil, ErrInvalidLength
+	}
+	if opts.TimePrefixBytes > 0 && opts.HybridRandomPrefix > 0 {
+		return nil, ErrIncompatibleOptions
+	}
+	var (
+		timePrefixWidth int
+		sortedAlphabet  []rune
+	)
+	if opts.TimePrefixBytes > 0 {
+		timePrefixWidth = encodedWidth(8*opts.TimePrefixBytes, int(alphabetLen))
+		sortedAlphabet = slices.Clone(alphabetRunes)
+		slices.Sort(sortedAlphabet)
+	}
+
+	// The version is encoded as a single alphabet character.
+	if opts.Versioned && int(opts.Version) >= int(alphabetLen) {
+		return nil, ErrInvalidVersion
+	}
+
+	// The prefix and suffix are free-form but must be valid UTF-8 so IDs remain valid strings.
+	if !utf8.ValidString(opts.Prefix) || !utf8.ValidString(opts.Suffix) {
+		return nil, ErrInvalidAffix
+	}
+
+	// The hybrid layout needs a fixed number of characters for its millisecond timestamp.
+	if opts.HybridRandomPrefix < 0 {
+		return nil, ErrInvalidLength
+	}
+	var hybridTimeWidth int
+	if opts.HybridRandomPrefix > 0 {
+		hybridTimeWidth = encodedWidth(hybridTimeBits, int(alphabetLen))
+	}
+
+	if opts.ZeroPadding < 0 {
+		return nil, ErrInvalidLength
+	}
+
+	if opts.MaxAttempts < 1 {
+		return nil, ErrInvalidMaxAttempts
+	}
+
+	// Record whether every character may appear in a file name on common operating systems.
+	isFilesystemSafe := true
+	for _, r := range alphabetRunes {
+		if !isFilesystemSafeRune(r) {
+			isFilesystemSafe = false
+			break
+		}
+	}
+
+	// Record whether every character is a lowercase ASCII letter or digit, so any ID is a valid DNS label.
+	isDNSLabelSafe := true
+	for _, r := range alphabetRunes {
+		if !isDNSLabelRune(r) {
+			isDNSLabelSafe = false
+			break
+		}
+	}
 
 	return &runtimeConfig{
 		randReader:       opts.RandReader,
+		regionCode:       opts.RegionCode,
+		prefix:           opts.Prefix,
+		suffix:           opts.Suffix,
 		byteAlphabet:     byteAlphabet,
 		runeAlphabet:     alphabetRunes,
+		runeIndex:        runeIndex,
 		mask:             mask,
 		bitsNeeded:       bitsNeeded,
 		bytesNeeded:      bytesNeeded,
@@ -336,12 +1158,44 @@ func buildRuntimeConfig(opts *ConfigOptions) (*runtimeConfig, error) {
 		baseMultiplier:   baseMultiplier,
 		alphabetLen:      alphabetLen,
 		isASCII:          isASCII,
+		isMonotonic:      opts.Monotonic,
+		isLengthPrefixed: opts.LengthPrefix,
+		isMixedCase:      opts.RequireMixedCase,
+		isNoRepeats:      opts.NoConsecutiveRepeats,
+		isVersioned:      opts.Versioned,
+		version:          opts.Version,
+		isFilesystemSafe: isFilesystemSafe,
+		isDNSLabelSafe:   isDNSLabelSafe,
+		maxLength:        opts.maxLength,
 		isPowerOfTwo:     isPowerOfTwo,
-		lengthHint:       opts.LengthHint,
+		lengthHint:       lengthHint,
+		minLength:        minLength,
+		maxAttempts:      opts.MaxAttempts,
+		entropyLength:    entropyLength,
+		hybridPrefix:     opts.HybridRandomPrefix,
+		hybridTimeWidth:  hybridTimeWidth,
 		maxBytesPerRune:  maxBytesPerRune,
+		zeroPadding:      opts.ZeroPadding,
+		timePrefixBytes:  opts.TimePrefixBytes,
+		timePrefixWidth:  timePrefixWidth,
+		sortedAlphabet:   sortedAlphabet,
 	}, nil
 }
 
+// dedupRunes returns runes with duplicates removed, preserving the order of first occurrence.
+func dedupRunes(runes []rune) []rune {
+	seen := make(map[rune]struct{}, len(runes))
+	unique := runes[:0:0]
+	for _, r := range runes {
+		if _, ok := seen[r]; ok {
+			continue
+		}
+		seen[r] = struct{}{}
+		unique = append(unique, r)
+	}
+	return unique
+}
+
 // AlphabetLen returns the number of unique characters in the provided alphabet.
 //
 // This length determines the range of indices for selecting characters during ID generation.
@@ -396,6 +1250,47 @@ func (r *runtimeConfig) BytesNeeded() uint {
 	return r.bytesNeeded
 }
 
+// EntropyLength returns the minimum ID length derived from WithMinEntropy,
+// or zero if no entropy requirement is configured.
+//
+// LengthHint and MinLength are at least this value.
+func (r *runtimeConfig) EntropyLength() int {
+	return r.entropyLength
+}
+
+// HasLengthPrefix returns true if each ID begins with a character encoding the length of its body.
+func (r *runtimeConfig) HasLengthPrefix() bool {
+	return r.isLengthPrefixed
+}
+
+// HybridRandomPrefix returns the number of random characters preceding the timestamp suffix
+// in the hybrid layout, or zero if the layout is not enabled.
+func (r *runtimeConfig) HybridRandomPrefix() int {
+	return r.hybridPrefix
+}
+
+// HasVersion returns true if every ID carries a version character.
+func (r *runtimeConfig) HasVersion() bool {
+	return r.isVersioned
+}
+
+// Indices returns the position within the alphabet of each character in the ID.
+//
+// It returns ErrInvalidCharacter if any character is not part of the alphabet.
+// This is a building block for decoders and validation tooling.
+func (r *runtimeConfig) Indices(id ID) ([]int, error) {
+	indices := make([]int, 0, len(id))
+	for _, c := range string(id) {
+		i, ok := r.runeIndex[c]
+		if !ok {
+			return nil, ErrInvalidCharacter
+		}
+		indices = append(indices, i)
+	}
+
+	return indices, nil
+}
+
 // IsASCII returns true if the alphabet consists solely of ASCII characters.
 //
 // This allows for optimization in processing, using bytes instead of runes for ID generation.
@@ -403,6 +1298,30 @@ func (r *runtimeConfig) IsASCII() bool {
 	return r.isASCII
 }
 
+// IsDNSLabelSafe returns true if the alphabet consists solely of lowercase ASCII letters and
+// digits, so every ID of up to 63 characters is a valid DNS label.
+//
+// Hyphens are valid inside DNS labels but not at either end, so alphabets containing
+// them are not considered safe.
+func (r *runtimeConfig) IsDNSLabelSafe() bool {
+	return r.isDNSLabelSafe
+}
+
+// IsFilesystemSafe returns true if no character in the alphabet is forbidden in file names
+// on Windows, macOS or Linux.
+//
+// This checks characters only; reserved names such as "CON" and case-insensitive filesystems
+// are handled by NewFilesystemSafeGenerator.
+func (r *runtimeConfig) IsFilesystemSafe() bool {
+	return r.isFilesystemSafe
+}
+
+// IsMonotonic returns true if successive IDs of the same length are guaranteed to
+// strictly increase in lexicographic order.
+func (r *runtimeConfig) IsMonotonic() bool {
+	return r.isMonotonic
+}
+
 // IsPowerOfTwo returns true if the length of the alphabet is a power of two.
 //
 // When true, random index selection can be optimized using bitwise operations,
@@ -418,6 +1337,20 @@ func (r *runtimeConfig) LengthHint() uint16 {
 	return r.lengthHint
 }
 
+// MinLength returns the shortest ID length the generator will produce.
+//
+// Requests for shorter IDs are rejected with ErrLengthTooShort.
+func (r *runtimeConfig) MinLength() int {
+	return r.minLength
+}
+
+// MaxAttempts returns the attempt budget for rejection sampling and candidate regeneration.
+//
+// Generation fails with ErrExceededMaxAttempts once the budget is exhausted.
+func (r *runtimeConfig) MaxAttempts() int {
+	return r.maxAttempts
+}
+
 // Mask returns the bitmask used to extract the necessary bits from randomly generated bytes.
 //
 // The mask is essential for efficiently mapping random values to valid alphabet indices,
@@ -426,6 +1359,17 @@ func (r *runtimeConfig) Mask() uint {
 	return r.mask
 }
 
+// NoConsecutiveRepeats returns true if no two adjacent random characters of an ID may be identical.
+func (r *runtimeConfig) NoConsecutiveRepeats() bool {
+	return r.isNoRepeats
+}
+
+// Prefix returns the fixed string prepended to every generated ID,
+// or an empty string if none is configured.
+func (r *runtimeConfig) Prefix() string {
+	return r.prefix
+}
+
 // RandReader returns the source of randomness used for generating IDs.
 //
 // It is typically a cryptographically secure random number generator (e.g., crypto/rand.Reader).
@@ -433,6 +1377,23 @@ func (r *runtimeConfig) RandReader() io.Reader {
 	return r.randReader
 }
 
+// RegionCode returns the fixed region code prepended to every generated ID,
+// or an empty string if none is configured.
+func (r *runtimeConfig) RegionCode() string {
+	return r.regionCode
+}
+
+// RequiresMixedCase returns true if every ID must contain both an uppercase and a lowercase letter.
+func (r *runtimeConfig) RequiresMixedCase() bool {
+	return r.isMixedCase
+}
+
+// Suffix returns the fixed string appended to every generated ID,
+// or an empty string if none is configured.
+func (r *runtimeConfig) Suffix() string {
+	return r.suffix
+}
+
 // RuneAlphabet returns the slice of runes representing the alphabet.
 //
 // This is used for ID generation when the alphabet includes non-ASCII (multibyte) characters,
@@ -454,3 +1415,20 @@ func (r *runtimeConfig) ScalingFactor() int {
 func (r *runtimeConfig) MaxBytesPerRune() int {
 	return r.maxBytesPerRune
 }
+
+// TimePrefixWidth returns the number of characters holding the creation timestamp at the
+// start of every ID's body, or zero if the time prefix is not enabled.
+func (r *runtimeConfig) TimePrefixWidth() int {
+	return r.timePrefixWidth
+}
+
+// Version returns the schema version encoded in every ID, if HasVersion is true.
+func (r *runtimeConfig) Version() byte {
+	return r.version
+}
+
+// ZeroPadding returns the default width to which EncodeUint64 left-pads its result,
+// or zero if padding is disabled.
+func (r *runtimeConfig) ZeroPadding() int {
+	return r.zeroPadding
+}
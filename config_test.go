@@ -6,8 +6,10 @@
 package nanoid
 
 import (
+	"math"
 	"math/bits"
 	"testing"
+	"unicode"
 
 	"github.com/sixafter/nanoid/x/crypto/prng"
 	"github.com/stretchr/testify/assert"
@@ -45,7 +47,175 @@ func TestGetConfig(t *testing.T) {
 	is.Equal(true, runtimeConfig.IsPowerOfTwo(), "Config.IsPowerOfTwo should be true by default")
 	is.Positive(runtimeConfig.LengthHint(), "Config.LengthHint should be a positive integer")
 	is.Equal(1, runtimeConfig.MaxBytesPerRune(), "Config.MaxBytesPerRune should be 1 by default")
+	is.Equal(1, runtimeConfig.(ExtendedConfig).MinLength(), "Config.MinLength should be 1 by default")
 	is.Equal(prng.Reader, runtimeConfig.RandReader(), "Config.RandReader should be rand.Reader by default")
 	is.NotNil(runtimeConfig.RuneAlphabet(), "Config.RuneAlphabet should not be nil")
 	is.Positive(runtimeConfig.ScalingFactor(), "Config.ScalingFactor should be a positive integer")
 }
+
+// TestWithUnicodeRange tests that WithUnicodeRange only includes runes from the requested category.
+func TestWithUnicodeRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Greek and Coptic block, which contains letters, marks, punctuation, and unassigned code points.
+	gen, err := NewGenerator(WithUnicodeRange(0x0370, 0x03FF, "L"))
+	is.NoError(err, "NewGenerator() should not return an error with a Greek letter alphabet")
+
+	config := gen.(Configuration).Config()
+	for _, r := range config.RuneAlphabet() {
+		is.True(unicode.IsLetter(r), "Alphabet should only contain letters, found %U", r)
+		is.True(r >= 0x0370 && r <= 0x03FF, "Alphabet should only contain runes in range, found %U", r)
+	}
+
+	id, err := gen.New(DefaultLength)
+	is.NoError(err, "New should not return an error")
+	is.True(isValidID(id, string(config.RuneAlphabet())), "Generated ID contains invalid characters")
+
+	// Unassigned code points (e.g. U+0378) must never be included.
+	is.NotContains(string(config.RuneAlphabet()), string(rune(0x0378)), "Alphabet should not contain unassigned code points")
+}
+
+// TestWithUnicodeRangeInvalid tests that WithUnicodeRange surfaces invalid ranges and categories.
+func TestWithUnicodeRangeInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := NewGenerator(WithUnicodeRange(0x0370, 0x03FF, "NotACategory"))
+	is.Equal(ErrInvalidAlphabet, err, "Expected ErrInvalidAlphabet for an unknown category")
+
+	_, err = NewGenerator(WithUnicodeRange(0x03FF, 0x0370, "L"))
+	is.Equal(ErrInvalidAlphabet, err, "Expected ErrInvalidAlphabet for an inverted range")
+
+	_, err = NewGenerator(WithUnicodeRange('a', 'a', "L"))
+	is.Equal(ErrAlphabetTooShort, err, "Expected ErrAlphabetTooShort for a single-rune range")
+
+	_, err = NewGenerator(WithUnicodeRange(0x4E00, 0x9FFF, "L"))
+	is.Equal(ErrAlphabetTooLong, err, "Expected ErrAlphabetTooLong for a range exceeding the maximum")
+
+	_, err = NewGenerator(WithUnicodeRange(math.MinInt32, math.MaxInt32, ""))
+	is.Equal(ErrAlphabetTooLong, err, "Expected ErrAlphabetTooLong for a range clamped to every code point")
+}
+
+// TestWithUnicodeRangeClamped tests that out-of-range bounds are clamped to valid code points.
+func TestWithUnicodeRangeClamped(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithUnicodeRange(math.MinInt32, '9', "Nd"))
+	is.NoError(err, "NewGenerator() should not return an error with a negative start")
+	is.Equal("0123456789", string(gen.(Configuration).Config().RuneAlphabet()))
+
+	gen, err = NewGenerator(WithUnicodeRange(0x1FBF0, math.MaxInt32, "Nd"))
+	is.NoError(err, "NewGenerator() should not return an error with an end beyond unicode.MaxRune")
+	is.Equal(10, len(gen.(Configuration).Config().RuneAlphabet()), "Segmented digits U+1FBF0..U+1FBF9 should be found")
+}
+
+// TestConfig_Indices tests that Indices maps each character of an ID to its alphabet position.
+func TestConfig_Indices(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithAlphabet("abc😊"))
+	is.NoError(err, "NewGenerator() should not return an error with a valid custom alphabet")
+
+	config := gen.(Configuration).Config()
+
+	indices, err := config.Indices("cab😊a")
+	is.NoError(err, "Indices should not return an error for a valid ID")
+	is.Equal([]int{2, 0, 1, 3, 0}, indices, "Indices should return the alphabet position of each character")
+
+	indices, err = config.Indices(EmptyID)
+	is.NoError(err, "Indices should not return an error for an empty ID")
+	is.Empty(indices, "Indices should be empty for an empty ID")
+
+	_, err = config.Indices("abz")
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a character outside the alphabet")
+}
+
+// TestWithAlphabetDedup tests that WithAlphabetDedup removes duplicate characters instead of erroring.
+func TestWithAlphabetDedup(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(
+		WithAlphabet("aabbcc"),
+		WithAlphabetDedup(true),
+	)
+	is.NoError(err, "NewGenerator() should not return an error when deduplication is enabled")
+
+	config := gen.(Configuration).Config()
+	is.Equal("abc", string(config.RuneAlphabet()), "Duplicates should be removed keeping the first occurrence")
+	is.Equal(uint16(3), config.AlphabetLen(), "Deduplicated alphabet should have 3 characters")
+
+	id, err := gen.New(DefaultLength)
+	is.NoError(err, "New should not return an error")
+	is.True(isValidID(id, "abc"), "Generated ID contains invalid characters")
+
+	gen, err = NewGenerator(
+		WithAlphabet("😊a😊b"),
+		WithAlphabetDedup(true),
+	)
+	is.NoError(err, "NewGenerator() should not return an error when deduplication is enabled")
+	is.Equal("😊ab", string(gen.(Configuration).Config().RuneAlphabet()), "Unicode duplicates should be removed")
+
+	_, err = NewGenerator(
+		WithAlphabet("aabbcc"),
+		WithAlphabetDedup(false),
+	)
+	is.Equal(ErrDuplicateCharacters, err, "Expected ErrDuplicateCharacters when deduplication is disabled")
+}
+
+// rejectingReader yields 'rejected' bytes of 0xFF, which no three-character alphabet accepts,
+// followed by zero bytes.
+type rejectingReader struct {
+	rejected int
+}
+
+func (r *rejectingReader) Read(p []byte) (int, error) {
+	for i := range p {
+		if r.rejected > 0 {
+			p[i] = 0xFF
+			r.rejected--
+		} else {
+			p[i] = 0
+		}
+	}
+	return len(p), nil
+}
+
+// TestWithMaxAttempts tests that the attempt budget is configurable and defaults to 10.
+func TestWithMaxAttempts(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithAlphabet("ABC"), WithRandReader(&rejectingReader{rejected: 60}))
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.Equal(10, gen.(Configuration).Config().(ExtendedConfig).MaxAttempts(), "The default budget should be 10")
+
+	_, err = gen.New(1)
+	is.Equal(ErrExceededMaxAttempts, err, "The default budget should be exhausted by 60 rejected values")
+
+	gen, err = NewGenerator(
+		WithAlphabet("ABC"),
+		WithRandReader(&rejectingReader{rejected: 60}),
+		WithMaxAttempts(50),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a larger budget")
+	is.Equal(50, gen.(Configuration).Config().(ExtendedConfig).MaxAttempts(), "Config should report the budget")
+
+	id, err := gen.New(1)
+	is.NoError(err, "A larger budget should outlast 60 rejected values")
+	is.Equal(ID("A"), id)
+}
+
+// TestWithMaxAttemptsInvalid tests rejection of a non-positive attempt budget.
+func TestWithMaxAttemptsInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, n := range []int{0, -1} {
+		_, err := NewGenerator(WithMaxAttempts(n))
+		is.Equal(ErrInvalidMaxAttempts, err, "Expected ErrInvalidMaxAttempts for n=%d", n)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// ContentGenerator is implemented by generators that can derive deterministic IDs from content.
+type ContentGenerator interface {
+	// NewFromContent generates a deterministic, content-addressed Nano ID of the specified length
+	// by mapping the SHA-256 digest of 'content' through the alphabet.
+	// Identical content always yields the same ID; collision resistance depends on the length.
+	//
+	// Usage:
+	//   id, err := generator.NewFromContent([]byte("hello, world"), 21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Content ID:", id)
+	NewFromContent(content []byte, length int) (ID, error)
+}
+
+// NewFromContent generates a content-addressed Nano ID of the specified length.
+//
+// The content is hashed with SHA-256 and the digest is expanded into a deterministic
+// stream that is mapped through the generator's alphabet using the same unbiased
+// rejection sampling as New. Identical content always yields an identical ID for a given
+// alphabet and length, which makes the result suitable for deduplication.
+//
+// The ID is not random: its collision resistance is bounded by its length and alphabet,
+// i.e. roughly length * log2(alphabetLen) bits, and never exceeds the 256 bits of SHA-256.
+// Short IDs derived from distinct content may collide.
+//
+// The ID honors the generator's leading alphabet, filters, prefix, suffix, version, region code
+// and length prefix, with the digest stream standing in for the random reader. Options that
+// make an ID depend on more than its content, namely the time prefix, hybrid layout, monotonic
+// ordering, Bloom filter and recent buffer, cannot be combined with NewFromContent.
+//
+// Parameters:
+//   - content []byte: The content to address.
+//   - length int: The desired number of characters in the generated Nano ID.
+//
+// Returns:
+//   - ID: The content-addressed Nano ID.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if the provided length is less than or equal to zero.
+//   - ErrLengthTooShort: Returned if the provided length is below the configured minimum length.
+//   - ErrIncompatibleOptions: Returned if the generator uses time-based or issued-ID options.
+//   - ErrExceededMaxAttempts: Returned if no candidate satisfies the configured filters.
+//
+// Usage Example:
+//
+//	id, err := generator.NewFromContent([]byte("hello, world"), 21)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Content ID:", id)
+func (g *generator) NewFromContent(content []byte, length int) (ID, error) {
+	if err := g.checkLength(length); err != nil {
+		return EmptyID, err
+	}
+
+	if g.config.timePrefixWidth > 0 || g.config.hybridPrefix > 0 ||
+		g.monotonic != nil || g.bloom != nil || g.recent != nil {
+		return EmptyID, ErrIncompatibleOptions
+	}
+
+	return g.generate(newHashStream(sha256.Sum256(content)), length)
+}
+
+// hashStream is a deterministic io.Reader that expands a 32-byte seed into an
+// arbitrarily long byte stream by hashing the seed with an incrementing counter:
+// block(i) = SHA-256(seed || uint64(i)).
+type hashStream struct {
+	seed    [sha256.Size]byte
+	block   [sha256.Size]byte
+	counter uint64
+	offset  int
+}
+
+// newHashStream returns a hashStream positioned at the beginning of the stream for seed.
+func newHashStream(seed [sha256.Size]byte) *hashStream {
+	return &hashStream{
+		seed:   seed,
+		offset: sha256.Size,
+	}
+}
+
+// Read fills b with the next len(b) bytes of the stream. It never returns an error.
+func (h *hashStream) Read(b []byte) (int, error) {
+	var input [sha256.Size + 8]byte
+	n := 0
+	for n < len(b) {
+		if h.offset == sha256.Size {
+			copy(input[:], h.seed[:])
+			binary.BigEndian.PutUint64(input[sha256.Size:], h.counter)
+			h.block = sha256.Sum256(input[:])
+			h.counter++
+			h.offset = 0
+		}
+
+		c := copy(b[n:], h.block[h.offset:])
+		h.offset += c
+		n += c
+	}
+
+	return n, nil
+}
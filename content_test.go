@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_NewFromContent tests that identical content yields identical IDs and different content differs.
+func TestGenerator_NewFromContent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	id1, err := gen.(ContentGenerator).NewFromContent([]byte("hello, world"), DefaultLength)
+	is.NoError(err, "NewFromContent should not return an error")
+	is.Equal(DefaultLength, len(id1), "Generated ID should have the specified length")
+	is.True(isValidID(id1, DefaultAlphabet), "Generated ID contains invalid characters")
+
+	id2, err := gen.(ContentGenerator).NewFromContent([]byte("hello, world"), DefaultLength)
+	is.NoError(err, "NewFromContent should not return an error")
+	is.Equal(id1, id2, "Identical content should produce identical IDs")
+
+	id3, err := gen.(ContentGenerator).NewFromContent([]byte("hello, world!"), DefaultLength)
+	is.NoError(err, "NewFromContent should not return an error")
+	is.NotEqual(id1, id3, "Different content should produce different IDs")
+
+	_, err = gen.(ContentGenerator).NewFromContent([]byte("hello, world"), 0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+}
+
+// TestGenerator_NewFromContentUnicode tests content-addressed IDs with a non-power-of-two Unicode alphabet.
+func TestGenerator_NewFromContentUnicode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	alphabet := "abc😊🚀"
+	gen, err := NewGenerator(WithAlphabet(alphabet))
+	is.NoError(err, "NewGenerator() should not return an error with a valid custom alphabet")
+
+	id1, err := gen.(ContentGenerator).NewFromContent([]byte("content"), 64)
+	is.NoError(err, "NewFromContent should not return an error")
+	is.Equal(64, len([]rune(id1)), "Generated ID should have the specified length")
+	is.True(isValidID(id1, alphabet), "Generated ID contains invalid characters")
+
+	id2, err := gen.(ContentGenerator).NewFromContent([]byte("content"), 64)
+	is.NoError(err, "NewFromContent should not return an error")
+	is.Equal(id1, id2, "Identical content should produce identical IDs")
+}
+
+// TestGenerator_NewFromContentDecorated tests that content-addressed IDs honor constraints and decorations.
+func TestGenerator_NewFromContentDecorated(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGoIdentifierGenerator(8)
+	is.NoError(err, "NewGoIdentifierGenerator() should not return an error")
+
+	for i := 0; i < 200; i++ {
+		id, err := gen.(ContentGenerator).NewFromContent([]byte{byte(i)}, 8)
+		is.NoError(err, "NewFromContent should not return an error")
+		is.False(id[0] >= '0' && id[0] <= '9', "Content ID %q should not start with a digit", id)
+	}
+
+	gen, err = NewGenerator(WithPrefix("usr_"), WithSuffix("_x"), WithVersion(1))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id1, err := gen.(ContentGenerator).NewFromContent([]byte("hello, world"), DefaultLength)
+	is.NoError(err, "NewFromContent should not return an error")
+	is.True(id1.HasPrefix("usr_"), "Content ID should carry the prefix")
+	is.True(strings.HasSuffix(string(id1), "_x"), "Content ID should carry the suffix")
+	is.NoError(gen.(Validator).ValidateStrict(id1, DefaultLength), "Content ID should validate")
+
+	id2, err := gen.(ContentGenerator).NewFromContent([]byte("hello, world"), DefaultLength)
+	is.NoError(err, "NewFromContent should not return an error")
+	is.Equal(id1, id2, "Identical content should produce identical IDs")
+
+	for _, opt := range []Option{WithTimePrefix(4), WithHybridLayout(8), WithMonotonic(true), WithBloomFilter(1024, 3), WithRecentBuffer(4)} {
+		gen, err = NewGenerator(opt)
+		is.NoError(err, "NewGenerator() should not return an error")
+
+		_, err = gen.(ContentGenerator).NewFromContent([]byte("hello, world"), DefaultLength)
+		is.Equal(ErrIncompatibleOptions, err, "Expected ErrIncompatibleOptions")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"context"
+	"io"
+)
+
+// ContextGenerator is implemented by generators that can generate IDs that honor a context's
+// cancellation.
+type ContextGenerator interface {
+	// NewWithContext generates a new Nano ID of the specified length, returning ctx.Err()
+	// if the context is done before the ID is complete.
+	//
+	// Usage:
+	//   id, err := generator.NewWithContext(r.Context(), 21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Generated ID:", id)
+	NewWithContext(ctx context.Context, length int) (ID, error)
+}
+
+// contextReader wraps an io.Reader and fails every read once its context is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// Read returns the context's error if it is done, and otherwise reads from the underlying reader.
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
+
+// NewWithContext generates a new Nano ID of the specified length, stopping promptly if ctx
+// is canceled or its deadline passes.
+//
+// The context is checked before generation starts and before every refill of the random
+// buffer, so very long IDs or slow custom readers are interrupted between reads. A read
+// already in progress is not interrupted. Contexts that can never be canceled, such as
+// context.Background(), use the same path as New.
+//
+// Parameters:
+//   - ctx context.Context: Controls cancellation of the generation.
+//   - length int: The desired number of characters in the generated Nano ID.
+//
+// Returns:
+//   - ID: The generated Nano ID.
+//   - error: An error object if the generation fails or the context is done.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if the provided length is less than or equal to zero.
+//   - ctx.Err(): Returned if the context is canceled or its deadline passes before the ID is complete.
+//
+// Usage Example:
+//
+//	id, err := generator.NewWithContext(r.Context(), 21)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Generated ID:", id)
+func (g *generator) NewWithContext(ctx context.Context, length int) (ID, error) {
+	if ctx.Done() == nil {
+		return g.New(length)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return EmptyID, err
+	}
+
+	if err := g.checkLength(length); err != nil {
+		return EmptyID, err
+	}
+
+	return g.generate(&contextReader{ctx: ctx, r: g.config.randReader}, length)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// slowReader delays every read to simulate a slow source of randomness.
+type slowReader struct {
+	delay time.Duration
+}
+
+// Read sleeps for the configured delay and then fills p with random bytes.
+func (s *slowReader) Read(p []byte) (int, error) {
+	time.Sleep(s.delay)
+	return rand.Read(p)
+}
+
+// TestGenerator_NewWithContext tests generation with live and background contexts.
+func TestGenerator_NewWithContext(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id, err := gen.(ContextGenerator).NewWithContext(context.Background(), DefaultLength)
+	is.NoError(err, "NewWithContext should not return an error with a background context")
+	is.Len(id, DefaultLength)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	id, err = gen.(ContextGenerator).NewWithContext(ctx, DefaultLength)
+	is.NoError(err, "NewWithContext should not return an error with a live context")
+	is.Len(id, DefaultLength)
+	is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+
+	_, err = gen.(ContextGenerator).NewWithContext(ctx, 0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a zero length")
+
+	cancel()
+	_, err = gen.(ContextGenerator).NewWithContext(ctx, DefaultLength)
+	is.ErrorIs(err, context.Canceled, "Expected context.Canceled for a canceled context")
+}
+
+// TestGenerator_NewWithContextCancelMidGeneration tests that cancellation interrupts a slow generation.
+func TestGenerator_NewWithContextCancelMidGeneration(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithRandReader(&slowReader{delay: 5 * time.Millisecond}))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	// A 100,000-character ID needs hundreds of buffer refills, taking seconds at 5ms per read.
+	start := time.Now()
+	_, err = gen.(ContextGenerator).NewWithContext(ctx, 100_000)
+	is.ErrorIs(err, context.Canceled, "Expected context.Canceled when canceled mid-generation")
+	is.Less(time.Since(start), time.Second, "Generation should stop promptly after cancellation")
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = gen.(ContextGenerator).NewWithContext(ctx, 100_000)
+	is.ErrorIs(err, context.DeadlineExceeded, "Expected context.DeadlineExceeded when the deadline passes")
+}
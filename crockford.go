@@ -0,0 +1,222 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+)
+
+// CrockfordGenerator is implemented by generators that can generate Crockford base32 IDs with
+// mod-37 check symbols.
+type CrockfordGenerator interface {
+	// NewCrockford generates a Crockford base32 Nano ID of the specified length followed by its
+	// mod-37 check symbol, which ValidateCrockford can later verify.
+	//
+	// Usage:
+	//   id, err := generator.NewCrockford(12)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Generated ID:", id)
+	NewCrockford(length int) (ID, error)
+
+	// NewBatchWithChecksums generates 'count' Crockford base32 Nano IDs of the specified length
+	// together with a parallel slice of their mod-37 check symbols, for bulk inserts that store
+	// the check symbol in its own column.
+	//
+	// Usage:
+	//   ids, checks, err := generator.NewBatchWithChecksums(1000, 12)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println(ids[0], string(checks[0]))
+	NewBatchWithChecksums(count, length int) ([]ID, []byte, error)
+}
+
+// crockfordCheckAlphabet holds the mod-37 check symbols: the base32 alphabet followed by
+// the five additional symbols for the values 32 through 36.
+const crockfordCheckAlphabet = AlphabetCrockfordBase32 + "*~$=U"
+
+// NewCrockfordGenerator creates a generator that draws from the Crockford base32 alphabet,
+// for use with NewCrockford and ValidateCrockford.
+//
+// The alphabet is always Crockford base32; any WithAlphabet option supplied by the caller is overridden.
+//
+// Parameters:
+//   - opts ...Option: Additional configuration options, such as WithRandReader or WithLengthHint.
+//
+// Returns:
+//   - Interface: A generator producing Crockford base32 IDs.
+//   - error: An error object if the generator could not be created.
+//
+// Usage:
+//
+//	generator, err := nanoid.NewCrockfordGenerator()
+//	if err != nil {
+//	    // handle error
+//	}
+//	id, err := generator.NewCrockford(12)
+func NewCrockfordGenerator(opts ...Option) (Interface, error) {
+	// Copy the options so that appending never writes into the caller's backing array.
+	options := make([]Option, 0, len(opts)+1)
+	options = append(options, opts...)
+	options = append(options, WithAlphabet(AlphabetCrockfordBase32))
+
+	return NewGenerator(options...)
+}
+
+// NewCrockford generates a Nano ID of the specified length and appends its Crockford mod-37
+// check symbol, so the returned ID is one character longer than length.
+//
+// The check symbol is the value of the ID, read as a base32 number, modulo 37. It detects any
+// single-character substitution and most adjacent transpositions when the ID is later checked
+// with ValidateCrockford. The generator must draw from the Crockford base32 alphabet, as those
+// created with NewCrockfordGenerator do.
+//
+// Parameters:
+//   - length int: The number of random characters, excluding the check symbol.
+//
+// Returns:
+//   - ID: The generated ID followed by its check symbol.
+//   - error: An error object if generation fails.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if length is less than or equal to zero.
+//   - ErrInvalidCharacter: Returned if the generator's alphabet is not Crockford base32.
+//
+// Usage:
+//
+//	id, err := generator.NewCrockford(12)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Generated ID:", id)
+func (g *generator) NewCrockford(length int) (ID, error) {
+	id, err := g.New(length)
+	if err != nil {
+		return EmptyID, err
+	}
+
+	check, err := crockfordCheckSymbol(string(id))
+	if err != nil {
+		return EmptyID, err
+	}
+
+	return id + ID(check), nil
+}
+
+// NewBatchWithChecksums generates 'count' Nano IDs of the specified length and a parallel slice
+// holding the Crockford mod-37 check symbol of each, so that checks[i] is the symbol NewCrockford
+// would append to ids[i]. The IDs themselves do not include the check symbol.
+//
+// The IDs are generated in a single batch as with NewN, which makes this cheaper than calling
+// NewCrockford repeatedly when the IDs and their check symbols are stored separately. The
+// generator must draw from the Crockford base32 alphabet, as those created with
+// NewCrockfordGenerator do.
+//
+// Parameters:
+//   - count int: The number of IDs to generate.
+//   - length int: The number of random characters in each ID, excluding the check symbol.
+//
+// Returns:
+//   - []ID: The generated IDs.
+//   - []byte: The check symbol of each ID, in the same order.
+//   - error: An error object if generation fails; no partial batch is returned.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if count or length is less than or equal to zero.
+//   - ErrInvalidCharacter: Returned if the generator's alphabet is not Crockford base32.
+//
+// Usage:
+//
+//	ids, checks, err := generator.NewBatchWithChecksums(1000, 12)
+//	if err != nil {
+//	    // handle error
+//	}
+//	for i, id := range ids {
+//	    _, err = stmt.Exec(string(id), string(checks[i]))
+//	}
+func (g *generator) NewBatchWithChecksums(count, length int) ([]ID, []byte, error) {
+	ids, err := g.NewN(count, length)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checks := make([]byte, len(ids))
+	for i, id := range ids {
+		if checks[i], err = crockfordCheckSymbol(string(id)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return ids, checks, nil
+}
+
+// ValidateCrockford reports whether id ends with the correct Crockford mod-37 check symbol
+// for the characters preceding it.
+//
+// Decoding follows the Crockford specification: lowercase letters are accepted, I and L are
+// read as 1, O is read as 0, and hyphens are ignored.
+//
+// Usage:
+//
+//	if !nanoid.ValidateCrockford(id) {
+//	    // reject the ID
+//	}
+func ValidateCrockford(id ID) bool {
+	s := crockfordNormalize(string(id))
+	if len(s) < 2 {
+		return false
+	}
+
+	check, err := crockfordCheckSymbol(s[:len(s)-1])
+	if err != nil {
+		return false
+	}
+
+	return check == s[len(s)-1]
+}
+
+// crockfordCheckSymbol computes the mod-37 check symbol for s, which must consist solely of
+// characters from the Crockford base32 alphabet.
+func crockfordCheckSymbol(s string) (byte, error) {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		v := strings.IndexByte(AlphabetCrockfordBase32, s[i])
+		if v < 0 {
+			return 0, ErrInvalidCharacter
+		}
+		sum = (sum*32 + v) % 37
+	}
+
+	return crockfordCheckAlphabet[sum], nil
+}
+
+// crockfordNormalize maps s onto the canonical Crockford symbols: letters are upper-cased,
+// I and L become 1, O becomes 0, and hyphens are removed.
+func crockfordNormalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+
+		switch c {
+		case '-':
+			continue
+		case 'I', 'L':
+			c = '1'
+		case 'O':
+			c = '0'
+		}
+		b.WriteByte(c)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCrockfordCheckSymbol tests the check symbol against known Crockford values.
+func TestCrockfordCheckSymbol(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input string
+		check byte
+	}{
+		{"0", '0'},
+		{"1", '1'},
+		{"Z", 'Z'},   // 31
+		{"10", '*'},  // 32
+		{"11", '~'},  // 33
+		{"12", '$'},  // 34
+		{"13", '='},  // 35
+		{"14", 'U'},  // 36
+		{"15", '0'},  // 37
+		{"16J", 'D'}, // 1234 mod 37 = 13
+	}
+
+	for _, tt := range tests {
+		check, err := crockfordCheckSymbol(tt.input)
+		is.NoError(err, "crockfordCheckSymbol(%q) should not return an error", tt.input)
+		is.Equal(string(tt.check), string(check), "Unexpected check symbol for %q", tt.input)
+	}
+
+	_, err := crockfordCheckSymbol("1U")
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a non-Crockford character")
+}
+
+// TestNewCrockford tests that generated IDs carry a valid check symbol.
+func TestNewCrockford(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewCrockfordGenerator(WithAlphabet("abc"))
+	is.NoError(err, "NewCrockfordGenerator() should not return an error")
+	is.Equal(AlphabetCrockfordBase32, string(gen.(Configuration).Config().ByteAlphabet()),
+		"The Crockford alphabet should override a caller-supplied alphabet")
+
+	for i := 0; i < 100; i++ {
+		id, err := gen.(CrockfordGenerator).NewCrockford(12)
+		is.NoError(err, "NewCrockford should not return an error")
+		is.Len(id, 13, "The ID should include the check symbol")
+		is.True(isValidID(id[:12], AlphabetCrockfordBase32), "The body should use the Crockford alphabet")
+		is.True(ValidateCrockford(id), "ValidateCrockford should accept %q", id)
+	}
+
+	_, err = gen.(CrockfordGenerator).NewCrockford(0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for zero length")
+
+	// A generator with a non-Crockford alphabet cannot produce a check symbol.
+	lower, err := NewGenerator(WithAlphabet("abc"))
+	is.NoError(err, "NewGenerator() should not return an error")
+	_, err = lower.(CrockfordGenerator).NewCrockford(12)
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a non-Crockford alphabet")
+}
+
+// TestNewBatchWithChecksums tests that each returned check symbol validates against its ID.
+func TestNewBatchWithChecksums(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewCrockfordGenerator()
+	is.NoError(err, "NewCrockfordGenerator() should not return an error")
+
+	ids, checks, err := gen.(CrockfordGenerator).NewBatchWithChecksums(200, 12)
+	is.NoError(err, "NewBatchWithChecksums should not return an error")
+	is.Len(ids, 200, "The batch should contain the requested number of IDs")
+	is.Len(checks, 200, "There should be one check symbol per ID")
+
+	for i, id := range ids {
+		is.Len(id, 12, "The ID should not include the check symbol")
+		is.True(ValidateCrockford(id+ID(checks[i])), "Check symbol %q should validate against %q", checks[i], id)
+	}
+
+	_, _, err = gen.(CrockfordGenerator).NewBatchWithChecksums(0, 12)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a zero count")
+
+	lower, err := NewGenerator(WithAlphabet("abc"))
+	is.NoError(err, "NewGenerator() should not return an error")
+	_, _, err = lower.(CrockfordGenerator).NewBatchWithChecksums(10, 12)
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a non-Crockford alphabet")
+}
+
+// TestNewCrockfordGeneratorOptions tests that the caller's options slice is not modified.
+func TestNewCrockfordGeneratorOptions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	opts := make([]Option, 1, 2)
+	opts[0] = WithLengthHint(12)
+	spare := opts[:2]
+	spare[1] = WithAlphabet("abc")
+
+	_, err := NewCrockfordGenerator(opts...)
+	is.NoError(err, "NewCrockfordGenerator() should not return an error")
+
+	gen, err := NewGenerator(spare...)
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.Equal("abc", string(gen.(Configuration).Config().ByteAlphabet()),
+		"The spare capacity of the caller's slice should be left untouched")
+}
+
+// TestValidateCrockford tests validation, normalization and error detection.
+func TestValidateCrockford(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.True(ValidateCrockford("16JD"), "Expected a valid check symbol")
+	is.True(ValidateCrockford("16jd"), "Lowercase input should be accepted")
+	is.True(ValidateCrockford("16-JD"), "Hyphens should be ignored")
+	is.True(ValidateCrockford("I6JD"), "I should be read as 1")
+	is.True(ValidateCrockford("L6JD"), "L should be read as 1")
+	is.True(ValidateCrockford("O"+"0"), "O should be read as 0")
+
+	is.False(ValidateCrockford("16JE"), "Expected an incorrect check symbol to be rejected")
+	is.False(ValidateCrockford("17JD"), "Expected a substituted character to be rejected")
+	is.False(ValidateCrockford("D"), "Expected an ID without a body to be rejected")
+	is.False(ValidateCrockford(EmptyID), "Expected an empty ID to be rejected")
+	is.False(ValidateCrockford("1U6JD"), "Expected a non-Crockford body character to be rejected")
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"sync"
+)
+
+// DistinctGenerator is implemented by generators that can generate IDs guaranteed not to collide
+// with a caller-supplied set.
+type DistinctGenerator interface {
+	// NewDistinct generates a new Nano ID of the specified length that is not present in 'existing',
+	// regenerating within the attempt budget. The caller owns the set and is responsible for updating it.
+	//
+	// Usage:
+	//   id, err := generator.NewDistinct(seen, 8)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   seen[id] = struct{}{}
+	NewDistinct(existing map[ID]struct{}, length int) (ID, error)
+
+	// NewAndRegister generates a new Nano ID of the specified length and atomically stores it in m,
+	// regenerating if the ID is already present.
+	//
+	// Usage:
+	//   var index sync.Map
+	//   id, err := generator.NewAndRegister(&index, 21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	NewAndRegister(m *sync.Map, length int) (ID, error)
+}
+
+// NewDistinct generates a new Nano ID of the specified length that is not present in 'existing'.
+//
+// Candidates found in the set are regenerated, up to the generator's attempt budget.
+// The caller owns the set; NewDistinct neither reads it concurrently with writes nor
+// adds the returned ID to it. This is a lightweight way to build a small set of
+// distinct IDs in a loop, particularly with short lengths where collisions are likely.
+//
+// Parameters:
+//   - existing map[ID]struct{}: The set of IDs the result must differ from. It may be nil.
+//   - length int: The desired number of characters in the generated Nano ID.
+//
+// Returns:
+//   - ID: A generated Nano ID not contained in 'existing'.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrExceededMaxAttempts: Returned if no novel ID was produced within the attempt budget.
+//
+// Usage Example:
+//
+//	seen := make(map[ID]struct{})
+//	for len(seen) < 10 {
+//	    id, err := generator.NewDistinct(seen, 4)
+//	    if err != nil {
+//	        // handle error
+//	    }
+//	    seen[id] = struct{}{}
+//	}
+func (g *generator) NewDistinct(existing map[ID]struct{}, length int) (ID, error) {
+	for attempts := 0; attempts < g.config.maxAttempts; attempts++ {
+		id, err := g.New(length)
+		if err != nil {
+			return EmptyID, err
+		}
+
+		if _, ok := existing[id]; !ok {
+			return id, nil
+		}
+	}
+
+	return EmptyID, ErrExceededMaxAttempts
+}
+
+// NewAndRegister generates a new Nano ID of the specified length and atomically registers it in m,
+// regenerating on the rare collision with an ID already present.
+//
+// The ID is stored as a key with an empty struct{} value using sync.Map.LoadOrStore, so concurrent
+// callers sharing the same map never receive the same ID. Callers that need to associate data with
+// the ID can overwrite the value afterwards with m.Store.
+//
+// Parameters:
+//   - m *sync.Map: The index to register the ID in.
+//   - length int: The desired number of characters in the generated Nano ID.
+//
+// Returns:
+//   - ID: A generated Nano ID, newly present in m.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrNilPointer: Returned if m is nil.
+//   - ErrExceededMaxAttempts: Returned if no unregistered ID was produced within the attempt budget.
+//
+// Usage Example:
+//
+//	var index sync.Map
+//	id, err := generator.NewAndRegister(&index, 21)
+//	if err != nil {
+//	    // handle error
+//	}
+//	index.Store(id, session)
+func (g *generator) NewAndRegister(m *sync.Map, length int) (ID, error) {
+	if m == nil {
+		return EmptyID, ErrNilPointer
+	}
+
+	for attempts := 0; attempts < g.config.maxAttempts; attempts++ {
+		id, err := g.New(length)
+		if err != nil {
+			return EmptyID, err
+		}
+
+		if _, loaded := m.LoadOrStore(id, struct{}{}); !loaded {
+			return id, nil
+		}
+	}
+
+	return EmptyID, ErrExceededMaxAttempts
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_NewDistinct tests that NewDistinct returns an ID not contained in the provided set.
+func TestGenerator_NewDistinct(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// The cyclic reader yields "AB", "CD", "AB", ... for 2-character IDs.
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&cyclicReader{data: []byte{0, 1, 2, 3}}),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a custom reader")
+
+	existing := map[ID]struct{}{"AB": {}}
+	id, err := gen.(DistinctGenerator).NewDistinct(existing, 2)
+	is.NoError(err, "NewDistinct should not return an error")
+	is.Equal(ID("CD"), id, "NewDistinct should skip the existing ID")
+	_, found := existing[id]
+	is.False(found, "Generated ID should be novel")
+
+	// Every ID the reader can produce is taken, so the attempt budget must be exhausted.
+	existing["CD"] = struct{}{}
+	_, err = gen.(DistinctGenerator).NewDistinct(existing, 2)
+	is.Equal(ErrExceededMaxAttempts, err, "Expected ErrExceededMaxAttempts when no novel ID exists")
+
+	// A nil set accepts any ID.
+	id, err = gen.(DistinctGenerator).NewDistinct(nil, 2)
+	is.NoError(err, "NewDistinct should not return an error with a nil set")
+	is.Equal(2, len(id), "Generated ID should have the specified length")
+}
+
+// TestGenerator_NewAndRegister tests that registered IDs are unique and present in the map.
+func TestGenerator_NewAndRegister(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// A 3-character alphabet with 6-character IDs has only 729 values, so collisions are common.
+	gen, err := NewGenerator(WithAlphabet("ABC"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	const (
+		goroutines = 4
+		perRoutine = 50
+	)
+
+	var (
+		index sync.Map
+		wg    sync.WaitGroup
+	)
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perRoutine; j++ {
+				id, err := gen.(DistinctGenerator).NewAndRegister(&index, 6)
+				if is.NoError(err, "NewAndRegister should not return an error") {
+					_, ok := index.Load(id)
+					is.True(ok, "The ID should be registered in the map")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	count := 0
+	index.Range(func(_, _ any) bool {
+		count++
+		return true
+	})
+	is.Equal(goroutines*perRoutine, count, "Every generated ID should be registered exactly once")
+
+	_, err = gen.(DistinctGenerator).NewAndRegister(nil, 6)
+	is.Equal(ErrNilPointer, err, "Expected ErrNilPointer for a nil map")
+}
+
+// TestGenerator_NewAndRegisterExhausted tests that a full index exhausts the attempt budget.
+func TestGenerator_NewAndRegisterExhausted(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&cyclicReader{data: []byte{0, 1, 2, 3}}),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a custom reader")
+
+	var index sync.Map
+	index.Store(ID("AB"), struct{}{})
+	index.Store(ID("CD"), struct{}{})
+
+	_, err = gen.(DistinctGenerator).NewAndRegister(&index, 2)
+	is.Equal(ErrExceededMaxAttempts, err, "Expected ErrExceededMaxAttempts when every ID is registered")
+}
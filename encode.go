@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math"
+	"math/big"
+)
+
+// encodedWidth returns the number of characters needed to represent any value of the
+// given bit width in an alphabet of the given size.
+func encodedWidth(bits int, alphabetLen int) int {
+	return int(math.Ceil(float64(bits) / math.Log2(float64(alphabetLen))))
+}
+
+// encodeBig renders n in the base of the alphabet, most significant digit first,
+// left-padded with the alphabet's first character to at least width characters.
+func encodeBig(n *big.Int, alphabet []rune, width int) ID {
+	base := big.NewInt(int64(len(alphabet)))
+	value := new(big.Int).Set(n)
+	digit := new(big.Int)
+
+	var digits []rune
+	for value.Sign() > 0 {
+		value.DivMod(value, base, digit)
+		digits = append(digits, alphabet[digit.Int64()])
+	}
+
+	for len(digits) < width {
+		digits = append(digits, alphabet[0])
+	}
+
+	// Digits were produced least significant first.
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+
+	return ID(digits)
+}
+
+// decodeBig interprets id as a number in the base of the alphabet, using index
+// to map each rune to its digit value.
+func decodeBig(id ID, alphabetLen int, index map[rune]int) (*big.Int, error) {
+	base := big.NewInt(int64(alphabetLen))
+	value := new(big.Int)
+	digit := new(big.Int)
+
+	for _, r := range string(id) {
+		i, ok := index[r]
+		if !ok {
+			return nil, ErrInvalidCharacter
+		}
+		value.Mul(value, base)
+		value.Add(value, digit.SetInt64(int64(i)))
+	}
+
+	return value, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math"
+)
+
+// EntropyGenerator is implemented by generators that can size IDs by their entropy.
+type EntropyGenerator interface {
+	// NewWithEntropyBits generates a new Nano ID carrying at least 'bits' of entropy,
+	// choosing the length as ceil(bits / log2(alphabetLen)).
+	//
+	// Usage:
+	//   id, err := generator.NewWithEntropyBits(128)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Generated ID:", id)
+	NewWithEntropyBits(bits float64) (ID, error)
+
+	// Entropy returns the bits of entropy in an ID of the generator's LengthHint.
+	//
+	// Usage:
+	//   log.Printf("ID entropy: %.1f bits", generator.Entropy())
+	Entropy() float64
+}
+
+// NewWithEntropyBits generates a new Nano ID carrying at least the requested number of bits
+// of entropy, letting the generator choose the length for its alphabet.
+//
+// The length is computed as ceil(bits / log2(alphabetLen)). For example, 128 bits with a
+// 64-character alphabet yields a 22-character ID.
+//
+// Parameters:
+//   - bits float64: The minimum number of bits of entropy the ID must carry.
+//
+// Returns:
+//   - ID: The generated Nano ID.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrInvalidEntropy: Returned if bits is not a positive, finite number, or requires
+//     a length that cannot be represented.
+//
+// Usage Example:
+//
+//	id, err := generator.NewWithEntropyBits(128)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Generated ID:", id)
+func (g *generator) NewWithEntropyBits(bits float64) (ID, error) {
+	length, err := lengthForEntropy(bits, int(g.config.alphabetLen))
+	if err != nil {
+		return EmptyID, err
+	}
+
+	return g.New(length)
+}
+
+// lengthForEntropy returns the minimum ID length that carries at least 'bits' of entropy
+// for an alphabet of the given size.
+func lengthForEntropy(bits float64, alphabetLen int) (int, error) {
+	if math.IsNaN(bits) || math.IsInf(bits, 0) || bits <= 0 {
+		return 0, ErrInvalidEntropy
+	}
+
+	length := math.Ceil(bits / math.Log2(float64(alphabetLen)))
+	if length > math.MaxUint16 {
+		return 0, ErrInvalidEntropy
+	}
+
+	return int(length), nil
+}
+
+// Entropy returns the number of bits of entropy carried by a random ID of the given length
+// drawn from an alphabet of 'alphabetLen' characters, computed as length · log2(alphabetLen).
+//
+// Parameters:
+//   - alphabetLen int: The number of characters in the alphabet.
+//   - length int: The number of random characters in each ID.
+//
+// Returns:
+//   - float64: The entropy in bits. It is 0 if alphabetLen or length is not positive.
+//
+// Usage Example:
+//
+//	bits := nanoid.Entropy(64, 21) // 126
+func Entropy(alphabetLen, length int) float64 {
+	if alphabetLen < 1 || length <= 0 {
+		return 0
+	}
+
+	return float64(length) * math.Log2(float64(alphabetLen))
+}
+
+// Entropy returns the number of bits of entropy carried by an ID of the generator's
+// LengthHint, drawn from its alphabet, for logging or asserting a scheme against a policy.
+//
+// The figure covers the random characters only and ignores constraints such as a leading
+// alphabet or filters, which reduce the entropy slightly.
+//
+// Returns:
+//   - float64: The entropy in bits.
+//
+// Usage Example:
+//
+//	log.Printf("ID entropy: %.1f bits", generator.Entropy())
+func (g *generator) Entropy() float64 {
+	return Entropy(int(g.config.alphabetLen), int(g.config.lengthHint))
+}
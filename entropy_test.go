@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_NewWithEntropyBits tests that the ID length is derived from the requested entropy.
+func TestGenerator_NewWithEntropyBits(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// The default alphabet has 64 characters, i.e. 6 bits per character.
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	id, err := gen.(EntropyGenerator).NewWithEntropyBits(128)
+	is.NoError(err, "NewWithEntropyBits should not return an error")
+	is.Equal(22, len(id), "128 bits with a 64-character alphabet should yield 22 characters")
+	is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+
+	id, err = gen.(EntropyGenerator).NewWithEntropyBits(6)
+	is.NoError(err, "NewWithEntropyBits should not return an error")
+	is.Equal(1, len(id), "6 bits with a 64-character alphabet should yield 1 character")
+
+	for _, bits := range []float64{0, -1, math.NaN(), math.Inf(1), 1e9} {
+		_, err = gen.(EntropyGenerator).NewWithEntropyBits(bits)
+		is.Equal(ErrInvalidEntropy, err, "Expected ErrInvalidEntropy for %v bits", bits)
+	}
+}
+
+// TestWithMinEntropy tests that the derived length is exposed and raises the length hint and minimum length.
+func TestWithMinEntropy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithMinEntropy(128))
+	is.NoError(err, "NewGenerator() should not return an error with a minimum entropy")
+
+	config := gen.(Configuration).Config().(ExtendedConfig)
+	is.Equal(22, config.EntropyLength(), "128 bits with a 64-character alphabet should need 22 characters")
+	is.Equal(uint16(22), config.LengthHint(), "The derived length should exceed the default length hint")
+	is.Equal(22, config.MinLength(), "The derived length should become the minimum length")
+
+	id, err := gen.New(config.EntropyLength())
+	is.NoError(err, "New should accept the derived length")
+	is.Len(id, 22)
+
+	_, err = gen.New(21)
+	is.Equal(ErrLengthTooShort, err, "Expected ErrLengthTooShort below the derived length")
+
+	// A larger length hint wins over the derived length.
+	gen, err = NewGenerator(WithMinEntropy(64), WithLengthHint(32))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	config = gen.(Configuration).Config().(ExtendedConfig)
+	is.Equal(11, config.EntropyLength())
+	is.Equal(uint16(32), config.LengthHint(), "The larger length hint should win")
+	is.Equal(11, config.MinLength())
+
+	gen, err = NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.Equal(0, gen.(Configuration).Config().(ExtendedConfig).EntropyLength(), "No entropy requirement should be configured by default")
+}
+
+// TestWithMinEntropyUnsatisfiable tests that invalid or unsatisfiable entropy requirements are rejected.
+func TestWithMinEntropyUnsatisfiable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, bits := range []float64{-1, math.NaN(), math.Inf(1), 1e9} {
+		_, err := NewGenerator(WithMinEntropy(bits))
+		is.Equal(ErrInvalidEntropy, err, "Expected ErrInvalidEntropy for %v bits", bits)
+	}
+
+	// A length prefix cannot encode lengths of 64 or more with the default alphabet.
+	_, err := NewGenerator(WithMinEntropy(400), WithLengthPrefix(true))
+	is.Equal(ErrInvalidEntropy, err, "Expected ErrInvalidEntropy beyond the encodable length")
+}
+
+// TestEntropy tests the entropy calculation for an alphabet and length.
+func TestEntropy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal(126.0, Entropy(64, DefaultLength), "21 characters of 6 bits should carry 126 bits")
+	is.Equal(8.0, Entropy(2, 8), "8 binary characters should carry 8 bits")
+	is.InDelta(21*math.Log2(36), Entropy(36, 21), 1e-9)
+	is.Zero(Entropy(64, 0), "A non-positive length should carry no entropy")
+	is.Zero(Entropy(0, 21), "A non-positive alphabet length should carry no entropy")
+}
+
+// TestGenerator_Entropy tests that the generator reports entropy for its alphabet and length hint.
+func TestGenerator_Entropy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.Equal(126.0, gen.(EntropyGenerator).Entropy(), "The default generator should carry 126 bits")
+
+	gen, err = NewGenerator(WithAlphabet("0123456789abcdef"), WithLengthHint(32))
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.Equal(128.0, gen.(EntropyGenerator).Entropy(), "32 hex characters should carry 128 bits")
+
+	gen, err = NewGenerator(WithMinEntropy(128))
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.GreaterOrEqual(gen.(EntropyGenerator).Entropy(), 128.0, "A minimum entropy should be reflected in the generator's entropy")
+}
@@ -20,6 +20,9 @@ var (
 	// ErrInvalidLength is returned when a specified length value for an operation is invalid.
 	ErrInvalidLength = errors.New("invalid length")
 
+	// ErrLengthTooShort is returned when a requested ID length is below the configured minimum length.
+	ErrLengthTooShort = errors.New("length is less than the configured minimum")
+
 	// ErrInvalidAlphabet is returned when the provided alphabet for generating IDs is invalid.
 	ErrInvalidAlphabet = errors.New("invalid alphabet")
 
@@ -38,4 +41,78 @@ var (
 
 	// ErrNilPointer is returned when a nil pointer is passed to a function that does not accept nil pointers.
 	ErrNilPointer = errors.New("nil pointer")
+
+	// ErrInvalidDictionary is returned when a word dictionary has fewer than 2 words,
+	// or contains empty or duplicate words.
+	ErrInvalidDictionary = errors.New("invalid dictionary")
+
+	// ErrInvalidSeparator is returned when a separator is not a valid Unicode code point.
+	ErrInvalidSeparator = errors.New("invalid separator")
+
+	// ErrLengthMismatch is returned when an ID does not have the expected number of characters.
+	ErrLengthMismatch = errors.New("length mismatch")
+
+	// ErrInvalidCharacter is returned when an ID contains a character that is not part of the alphabet.
+	ErrInvalidCharacter = errors.New("character not in alphabet")
+
+	// ErrValueOutOfRange is returned when a decoded value does not fit in the target type.
+	ErrValueOutOfRange = errors.New("value out of range")
+
+	// ErrInvalidRegionCode is returned when a region code contains characters outside the alphabet.
+	ErrInvalidRegionCode = errors.New("invalid region code")
+
+	// ErrInvalidAffix is returned when a configured prefix or suffix is not valid UTF-8,
+	// or when an ID does not carry the configured prefix and suffix.
+	ErrInvalidAffix = errors.New("invalid prefix or suffix")
+
+	// ErrInvalidVersion is returned when a configured version has no corresponding alphabet character.
+	ErrInvalidVersion = errors.New("invalid version")
+
+	// ErrNoVersion is returned when a version is requested from a generator without one configured.
+	ErrNoVersion = errors.New("no version configured")
+
+	// ErrVersionMismatch is returned when an ID does not carry the generator's version character.
+	ErrVersionMismatch = errors.New("version mismatch")
+
+	// ErrNoRegionCode is returned when extracting a region code from a generator that has none configured.
+	ErrNoRegionCode = errors.New("no region code configured")
+
+	// ErrNoLengthPrefix is returned when a length is requested from a generator without a length prefix.
+	ErrNoLengthPrefix = errors.New("no length prefix configured")
+
+	// ErrNoHybridLayout is returned when a timestamp is requested from a generator without the hybrid layout.
+	ErrNoHybridLayout = errors.New("no hybrid layout configured")
+
+	// ErrInvalidID is returned, wrapped with details of the problem, when a string is not a well-formed ID.
+	ErrInvalidID = errors.New("invalid ID")
+
+	// ErrUnsupportedType is returned, wrapped with the offending type, when a value of an
+	// unsupported type is converted to an ID.
+	ErrUnsupportedType = errors.New("unsupported type")
+
+	// ErrInvalidRingSize is returned when a consistent hash ring has no positions.
+	ErrInvalidRingSize = errors.New("invalid ring size")
+
+	// ErrIncompatibleOptions is returned when a generator is configured with options that cannot be combined.
+	ErrIncompatibleOptions = errors.New("incompatible options")
+
+	// ErrInvalidCount is returned when a negative number of IDs is requested.
+	ErrInvalidCount = errors.New("invalid count")
+
+	// ErrInvalidBloomFilter is returned when the Bloom filter size or hash count is not positive.
+	ErrInvalidBloomFilter = errors.New("invalid bloom filter parameters")
+
+	// ErrMonotonicOverflow is returned when a monotonic generator has exhausted every ID of the
+	// requested length that sorts after the previously issued one.
+	ErrMonotonicOverflow = errors.New("monotonic sequence exhausted")
+
+	// ErrInvalidEntropy is returned when a requested amount of entropy is not a positive,
+	// finite number or cannot be satisfied by a representable ID length.
+	ErrInvalidEntropy = errors.New("invalid entropy")
+
+	// ErrInvalidMaxAttempts is returned when the configured attempt budget is not positive.
+	ErrInvalidMaxAttempts = errors.New("invalid max attempts")
+
+	// ErrBufferTooSmall is returned when a caller-supplied buffer cannot hold the requested ID.
+	ErrBufferTooSmall = errors.New("buffer too small")
 )
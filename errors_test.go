@@ -195,3 +195,30 @@ func TestErrNilPointer_UnmarshalBinary(t *testing.T) {
 	err := id.UnmarshalBinary([]byte("test"))
 	is.Equal(ErrNilPointer, err)
 }
+
+// TestErrLengthTooShort verifies that the generator returns ErrLengthTooShort
+// when the requested length is below the configured minimum, and succeeds at or above it.
+func TestErrLengthTooShort(t *testing.T) {
+	t.Parallel()
+
+	is := assert.New(t)
+
+	generator, err := NewGenerator(WithMinLength(16))
+	is.NoError(err, "Expected no error when initializing generator with a minimum length")
+
+	_, err = generator.New(15)
+	is.Equal(ErrLengthTooShort, err)
+
+	var buf []byte
+	_, err = generator.(BufferGenerator).NewInto(&buf, 15)
+	is.Equal(ErrLengthTooShort, err)
+
+	for _, length := range []int{16, 17, DefaultLength} {
+		id, err := generator.New(length)
+		is.NoError(err, "Expected no error at or above the minimum length")
+		is.Equal(length, len(id))
+	}
+
+	_, err = NewGenerator(WithMinLength(0))
+	is.Equal(ErrInvalidLength, err)
+}
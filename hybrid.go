@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math/big"
+	"strings"
+	"time"
+)
+
+// hybridTimeBits is the width of the millisecond timestamp in the hybrid layout,
+// enough to represent dates until the year 10889.
+const hybridTimeBits = 48
+
+// timestampSuffix encodes t as Unix milliseconds in the alphabet's base, left-padded to width characters.
+func (g *generator) timestampSuffix(t time.Time, width int) ID {
+	ms := new(big.Int).SetInt64(t.UnixMilli())
+	return encodeBig(ms, g.config.runeAlphabet, width)
+}
+
+// TimeWindow recovers the timestamp embedded in an ID generated with WithHybridLayout.
+//
+// The returned time has millisecond resolution: it identifies the millisecond window in which
+// the ID was generated, not the exact instant. The configured region code and length prefix,
+// if any, are skipped before the random prefix and timestamp suffix are located.
+//
+// Parameters:
+//   - id ID: The ID to inspect.
+//
+// Returns:
+//   - time.Time: The start of the millisecond in which the ID was generated.
+//   - error: An error object if the timestamp cannot be recovered.
+//
+// Error Conditions:
+//   - ErrNoHybridLayout: Returned if the generator does not use the hybrid layout.
+//   - ErrInvalidAffix: Returned if the ID does not carry the configured prefix and suffix.
+//   - ErrVersionMismatch: Returned if the ID does not carry the configured version character.
+//   - ErrInvalidRegionCode: Returned if the ID does not start with the configured region code.
+//   - ErrInvalidLength: Returned if the ID is too short to contain a timestamp.
+//   - ErrInvalidCharacter: Returned if the timestamp contains a character outside the alphabet.
+//   - ErrValueOutOfRange: Returned if the timestamp does not fit in the supported range.
+//
+// Usage Example:
+//
+//	ts, err := generator.TimeWindow(id)
+//	if err != nil {
+//	    // handle error
+//	}
+//	if ts.Before(cutoff) {
+//	    // the ID predates the cutoff
+//	}
+func (g *generator) TimeWindow(id ID) (time.Time, error) {
+	if g.config.hybridPrefix == 0 {
+		return time.Time{}, ErrNoHybridLayout
+	}
+
+	s, err := g.trimFixed(string(id))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if g.config.regionCode != "" {
+		if !strings.HasPrefix(s, g.config.regionCode) {
+			return time.Time{}, ErrInvalidRegionCode
+		}
+		s = s[len(g.config.regionCode):]
+	}
+
+	runes := []rune(s)
+	if g.config.isLengthPrefixed && len(runes) > 0 {
+		runes = runes[1:]
+	}
+
+	if len(runes)-g.config.hybridPrefix < g.config.hybridTimeWidth {
+		return time.Time{}, ErrInvalidLength
+	}
+
+	ms, err := decodeBig(ID(runes[g.config.hybridPrefix:]), int(g.config.alphabetLen), g.config.runeIndex)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if ms.BitLen() > hybridTimeBits {
+		return time.Time{}, ErrValueOutOfRange
+	}
+
+	return time.UnixMilli(ms.Int64()), nil
+}
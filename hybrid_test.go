@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithHybridLayout tests recovering the time window from generated IDs.
+func TestWithHybridLayout(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithHybridLayout(13))
+	is.NoError(err, "NewGenerator() should not return an error with a hybrid layout")
+	is.Equal(13, gen.(Configuration).Config().(ExtendedConfig).HybridRandomPrefix())
+
+	for _, length := range []int{21, 30} {
+		before := time.Now().Truncate(time.Millisecond)
+		id, err := gen.New(length)
+		after := time.Now()
+		is.NoError(err, "New(%d) should not return an error", length)
+		is.Len(id, length, "The length should include the timestamp suffix")
+		is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+
+		ts, err := gen.(Inspector).TimeWindow(id)
+		is.NoError(err, "TimeWindow should not return an error")
+		is.False(ts.Before(before), "The time window should not precede generation")
+		is.False(ts.After(after), "The time window should not follow generation")
+	}
+
+	_, err = gen.New(20)
+	is.Equal(ErrLengthTooShort, err, "Expected ErrLengthTooShort without room for the timestamp")
+}
+
+// TestWithHybridLayoutDecorations tests the hybrid layout together with other decorations.
+func TestWithHybridLayoutDecorations(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithHybridLayout(4), WithRegionCode("eu"), WithLengthPrefix(true))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id, err := gen.New(12)
+	is.NoError(err, "New should not return an error")
+	is.Len(id, 15, "The ID should include the region code and length character")
+
+	ts, err := gen.(Inspector).TimeWindow(id)
+	is.NoError(err, "TimeWindow should not return an error")
+	is.WithinDuration(time.Now(), ts, time.Minute)
+
+	_, err = gen.(Inspector).TimeWindow("us" + id[2:])
+	is.Equal(ErrInvalidRegionCode, err, "Expected ErrInvalidRegionCode for a foreign region")
+}
+
+// TestTimeWindowErrors tests TimeWindow with malformed input.
+func TestTimeWindowErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := Generator.(Inspector).TimeWindow("abc")
+	is.Equal(ErrNoHybridLayout, err, "Expected ErrNoHybridLayout")
+
+	gen, err := NewGenerator(WithHybridLayout(2))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.(Inspector).TimeWindow("abcdefg")
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a truncated ID")
+
+	_, err = gen.(Inspector).TimeWindow("ab!bcdefgh")
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a character outside the alphabet")
+
+	maxDigit := DefaultAlphabet[len(DefaultAlphabet)-1:]
+	_, err = gen.(Inspector).TimeWindow(ID("ab" + strings.Repeat(maxDigit, 9)))
+	is.Equal(ErrValueOutOfRange, err, "Expected ErrValueOutOfRange for a timestamp wider than 48 bits")
+
+	_, err = NewGenerator(WithHybridLayout(-1))
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a negative random prefix")
+}
@@ -6,7 +6,13 @@
 package nanoid
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
 	"strings"
+	"unicode/utf8"
 )
 
 // ID represents a Nano ID as a string.
@@ -39,6 +45,77 @@ func (id *ID) Compare(other ID) int {
 	return strings.Compare(string(*id), string(other))
 }
 
+// Equal reports whether the ID is identical to other. Two EmptyID values are equal.
+//
+// Usage:
+//
+//	if id.Equal(expected) {
+//	    // ...
+//	}
+func (id *ID) Equal(other ID) bool {
+	return id.Compare(other) == 0
+}
+
+// HasPrefix reports whether the string form of the ID begins with prefix,
+// which is convenient for filtering IDs by namespace.
+//
+// Usage:
+//
+//	if id.HasPrefix("usr_") {
+//	    // handle a user ID
+//	}
+func (id *ID) HasPrefix(prefix string) bool {
+	return strings.HasPrefix(string(*id), prefix)
+}
+
+// Valid reports whether the ID is non-empty and every character appears in the given alphabet.
+// Characters are compared as runes, so multibyte Unicode alphabets are handled correctly.
+//
+// This is useful for screening IDs from untrusted input, such as query parameters or headers,
+// before looking them up.
+//
+// Parameters:
+//   - alphabet string: The alphabet the ID is expected to be drawn from.
+//
+// Returns:
+//   - bool: true if the ID is non-empty and consists solely of characters from the alphabet.
+//
+// Usage:
+//
+//	id := ID(r.URL.Query().Get("id"))
+//	if !id.Valid(nanoid.DefaultAlphabet) {
+//	    // reject the request
+//	}
+func (id *ID) Valid(alphabet string) bool {
+	if id == nil {
+		return false
+	}
+
+	return IsValid(string(*id), alphabet)
+}
+
+// IsValid reports whether id is non-empty and every character appears in the given alphabet.
+// It is the string counterpart of ID.Valid for callers that have not wrapped their value in ID.
+//
+// Usage:
+//
+//	if !nanoid.IsValid(r.Header.Get("X-Request-ID"), nanoid.DefaultAlphabet) {
+//	    // reject the request
+//	}
+func IsValid(id, alphabet string) bool {
+	if id == "" || !utf8.ValidString(id) {
+		return false
+	}
+
+	for _, r := range id {
+		if !strings.ContainsRune(alphabet, r) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String returns the string representation of the ID.
 // It implements the fmt.Stringer interface, allowing the ID to be
 // used seamlessly with fmt package functions like fmt.Println and fmt.Printf.
@@ -51,6 +128,67 @@ func (id *ID) String() string {
 	return string(*id)
 }
 
+// Format implements the fmt.Formatter interface, giving the ID predictable output
+// in logs and formatted strings. Width, precision and flags are honored as they are
+// for strings.
+//
+// Supported verbs:
+//   - %s, %v: the ID itself.
+//   - %q: a double-quoted Go string literal, escaping control characters.
+//   - %x, %X: lowercase or uppercase hexadecimal encoding of the ID's bytes.
+//
+// A nil ID is formatted as "<nil>". Any other verb produces fmt's usual
+// "%!verb(nanoid.ID=...)" error string.
+//
+// Example:
+//
+//	id := ID("abc")
+//	fmt.Printf("%q %x %8s\n", &id, &id, &id) // Output: "abc" 616263      abc
+func (id *ID) Format(f fmt.State, verb rune) {
+	if id == nil {
+		_, _ = io.WriteString(f, "<nil>")
+		return
+	}
+
+	switch verb {
+	case 's', 'v', 'q', 'x', 'X':
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), string(*id))
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(nanoid.ID=%s)", verb, string(*id))
+	}
+}
+
+// Bytes returns a copy of the ID's underlying UTF-8 bytes.
+// Modifying the returned slice does not affect the ID.
+//
+// Example:
+//
+//	id := Must()
+//	b := id.Bytes()
+//	fmt.Println(len(b)) // Output: 21
+func (id *ID) Bytes() []byte {
+	if id == nil {
+		return nil
+	}
+
+	return []byte(*id)
+}
+
+// Len returns the number of characters (runes) in the ID, rather than the number of bytes,
+// so IDs drawn from multibyte Unicode alphabets report their logical length.
+//
+// Example:
+//
+//	id := ID("αβγ")
+//	fmt.Println(id.Len()) // Output: 3
+func (id *ID) Len() int {
+	if id == nil {
+		return 0
+	}
+
+	return utf8.RuneCountInString(string(*id))
+}
+
 // MarshalText converts the ID to a byte slice.
 // It implements the encoding.TextMarshaler interface, enabling the ID
 // to be marshaled into text-based formats such as XML and YAML.
@@ -75,6 +213,33 @@ func (id *ID) MarshalText() ([]byte, error) {
 	return []byte(*id), nil
 }
 
+// AppendText appends the ID to b and returns the extended buffer.
+// It implements the encoding.TextAppender interface introduced in Go 1.24, letting
+// high-throughput encoders write the ID into a reusable buffer without the
+// allocation made by MarshalText.
+//
+// Parameters:
+//   - b: The buffer to append to. It may be nil.
+//
+// Returns:
+//   - The buffer with the ID appended.
+//   - An error if the ID is nil.
+//
+// Example:
+//
+//	buf := make([]byte, 0, 1024)
+//	for _, id := range ids {
+//	    buf, _ = id.AppendText(buf)
+//	    buf = append(buf, '\n')
+//	}
+func (id *ID) AppendText(b []byte) ([]byte, error) {
+	if id == nil {
+		return b, ErrNilPointer
+	}
+
+	return append(b, *id...), nil
+}
+
 // UnmarshalText parses a byte slice and assigns the result to the ID.
 // It implements the encoding.TextUnmarshaler interface, allowing the ID
 // to be unmarshaled from text-based formats.
@@ -102,6 +267,72 @@ func (id *ID) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalJSON encodes the ID as a JSON string.
+// It implements the json.Marshaler interface, so the ID serializes as a quoted
+// string even where an encoder does not honor encoding.TextMarshaler.
+//
+// Returns:
+//   - A byte slice containing the quoted ID.
+//   - An error if the marshaling fails.
+//
+// Example:
+//
+//	id := Must()
+//	data, err := id.MarshalJSON()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(string(data)) // Output: "V1StGXR8_Z5jdHi6B-myT"
+func (id *ID) MarshalJSON() ([]byte, error) {
+	if id == nil {
+		return nil, ErrNilPointer
+	}
+
+	return json.Marshal(string(*id))
+}
+
+// UnmarshalJSON decodes a JSON string and assigns the result to the ID.
+// It implements the json.Unmarshaler interface. JSON null is mapped to EmptyID;
+// numbers, booleans, objects and arrays are rejected with an error wrapping ErrUnsupportedType.
+//
+// Parameters:
+//   - data: A byte slice containing the JSON value.
+//
+// Returns:
+//   - An error if the ID is nil or the JSON value is not a string or null.
+//
+// Example:
+//
+//	var id ID
+//	err := id.UnmarshalJSON([]byte(`"V1StGXR8_Z5jdHi6B-myT"`))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(id) // Output: V1StGXR8_Z5jdHi6B-myT
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if id == nil {
+		return ErrNilPointer
+	}
+
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*id = EmptyID
+		return nil
+	}
+
+	if len(data) == 0 || data[0] != '"' {
+		return fmt.Errorf("%w: JSON value %s is not a string", ErrUnsupportedType, data)
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	*id = ID(s)
+	return nil
+}
+
 // MarshalBinary converts the ID to a byte slice.
 // It implements the encoding.BinaryMarshaler interface, enabling the ID
 // to be marshaled into binary formats for efficient storage or transmission.
@@ -152,3 +383,64 @@ func (id *ID) UnmarshalBinary(data []byte) error {
 	*id = ID(data)
 	return nil
 }
+
+// Value returns the ID as a string for storage in a database.
+// It implements the driver.Valuer interface, allowing an ID to be passed
+// directly as a query argument.
+//
+// Returns:
+//   - driver.Value: The string form of the ID.
+//   - An error if the ID is nil.
+//
+// Example:
+//
+//	id := Must()
+//	_, err := db.Exec("INSERT INTO items (id) VALUES ($1)", &id)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (id *ID) Value() (driver.Value, error) {
+	if id == nil {
+		return nil, ErrNilPointer
+	}
+
+	return string(*id), nil
+}
+
+// Scan assigns a value read from a database to the ID.
+// It implements the sql.Scanner interface, allowing an ID to be used
+// directly as a destination in Row.Scan for text and bytea columns.
+//
+// Parameters:
+//   - src: The database value, which may be a string, a []byte, or nil (mapped to EmptyID).
+//
+// Returns:
+//   - An error if the ID is nil or the source type is not supported.
+//
+// Example:
+//
+//	var id ID
+//	err := db.QueryRow("SELECT id FROM items LIMIT 1").Scan(&id)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(id) // Output: V1StGXR8_Z5jdHi6B-myT
+func (id *ID) Scan(src any) error {
+	if id == nil {
+		return ErrNilPointer
+	}
+
+	switch v := src.(type) {
+	case string:
+		*id = ID(v)
+	case []byte:
+		// The driver may reuse the slice, so the conversion copies it.
+		*id = ID(v)
+	case nil:
+		*id = EmptyID
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedType, src)
+	}
+
+	return nil
+}
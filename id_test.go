@@ -6,6 +6,10 @@
 package nanoid
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -149,3 +153,313 @@ func TestID_IsEmpty(t *testing.T) {
 	// Case 2: id2 is empty
 	is.True(id2.IsEmpty(), "id2 should be empty")
 }
+
+// TestID_Valid tests the Valid() method of the ID type and the IsValid function.
+func TestID_Valid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id := Must()
+	is.True(id.Valid(DefaultAlphabet), "A generated ID should be valid for its alphabet")
+	is.True(IsValid(string(id), DefaultAlphabet), "IsValid should agree with ID.Valid")
+
+	tests := []struct {
+		id       string
+		alphabet string
+		expected bool
+	}{
+		{"abc", "abc", true},
+		{"aaa", "abc", true},
+		{"abd", "abc", false},
+		{"", "abc", false},
+		{"αβγ", "αβγδ", true},
+		{"αβz", "αβγδ", false},
+		{"a", "", false},
+		{string([]byte{0xff}), "abc\xff", false},
+	}
+
+	for _, tt := range tests {
+		candidate := ID(tt.id)
+		is.Equal(tt.expected, candidate.Valid(tt.alphabet), "ID(%q).Valid(%q)", tt.id, tt.alphabet)
+		is.Equal(tt.expected, IsValid(tt.id, tt.alphabet), "IsValid(%q, %q)", tt.id, tt.alphabet)
+	}
+
+	var nilID *ID
+	is.False(nilID.Valid(DefaultAlphabet), "A nil ID should not be valid")
+}
+
+// Compile-time checks that ID implements the database/sql interfaces.
+var (
+	_ sql.Scanner   = (*ID)(nil)
+	_ driver.Valuer = (*ID)(nil)
+)
+
+// TestID_Value tests the Value() method of the ID type.
+// It verifies that Value() returns the string form of the ID.
+func TestID_Value(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id := Must()
+	value, err := id.Value()
+	is.NoError(err, "Value() should not return an error")
+	is.Equal(string(id), value, "Value() should return the string form of the ID")
+
+	var nilID *ID
+	_, err = nilID.Value()
+	is.Equal(ErrNilPointer, err, "Value() on a nil ID should return ErrNilPointer")
+}
+
+// TestID_Scan tests the Scan() method of the ID type for each supported source type.
+func TestID_Scan(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	expected := Must()
+
+	tests := []struct {
+		name     string
+		src      any
+		expected ID
+	}{
+		{"string", string(expected), expected},
+		{"bytes", []byte(expected), expected},
+		{"nil", nil, EmptyID},
+	}
+
+	for _, tt := range tests {
+		id := ID("previous")
+		is.NoError(id.Scan(tt.src), "Scan(%s) should not return an error", tt.name)
+		is.Equal(tt.expected, id, "Scan(%s) should assign the source value", tt.name)
+	}
+
+	// The scanned ID must not alias the driver's buffer.
+	buf := []byte("abc")
+	var id ID
+	is.NoError(id.Scan(buf))
+	buf[0] = 'x'
+	is.Equal(ID("abc"), id, "Scan should copy []byte sources")
+
+	err := id.Scan(42)
+	is.ErrorIs(err, ErrUnsupportedType, "Scan should reject unsupported source types")
+	is.EqualError(err, "unsupported type: int")
+
+	var nilID *ID
+	is.Equal(ErrNilPointer, nilID.Scan("abc"), "Scan() on a nil ID should return ErrNilPointer")
+}
+
+// TestID_MarshalJSON tests the MarshalJSON() method of the ID type.
+// It verifies that the ID is encoded as a quoted JSON string.
+func TestID_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id := Must()
+	data, err := id.MarshalJSON()
+	is.NoError(err, "MarshalJSON() should not return an error")
+	is.Equal(`"`+string(id)+`"`, string(data), "MarshalJSON() should return a quoted string")
+
+	special := ID(`a"b\c`)
+	data, err = special.MarshalJSON()
+	is.NoError(err, "MarshalJSON() should not return an error")
+	is.Equal(`"a\"b\\c"`, string(data), "MarshalJSON() should escape special characters")
+
+	type record struct {
+		ID ID `json:"id"`
+	}
+	data, err = json.Marshal(&record{ID: id})
+	is.NoError(err, "json.Marshal should not return an error")
+	is.Equal(`{"id":"`+string(id)+`"}`, string(data), "An embedded ID should serialize as a string")
+
+	var nilID *ID
+	_, err = nilID.MarshalJSON()
+	is.Equal(ErrNilPointer, err, "MarshalJSON() on a nil ID should return ErrNilPointer")
+}
+
+// TestID_UnmarshalJSON tests the UnmarshalJSON() method of the ID type.
+// It verifies that JSON strings and null are accepted and other JSON values are rejected.
+func TestID_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	expected := Must()
+	var id ID
+	is.NoError(id.UnmarshalJSON([]byte(`"`+string(expected)+`"`)), "UnmarshalJSON() should accept a string")
+	is.Equal(expected, id)
+
+	is.NoError(id.UnmarshalJSON([]byte(`"a\"b"`)), "UnmarshalJSON() should decode escapes")
+	is.Equal(ID(`a"b`), id)
+
+	is.NoError(id.UnmarshalJSON([]byte("null")), "UnmarshalJSON() should accept null")
+	is.Equal(EmptyID, id, "null should map to EmptyID")
+
+	for _, input := range []string{"42", "true", `{"id":"abc"}`, `["abc"]`, ""} {
+		err := id.UnmarshalJSON([]byte(input))
+		is.ErrorIs(err, ErrUnsupportedType, "UnmarshalJSON(%q) should reject non-string JSON", input)
+	}
+
+	var record struct {
+		ID ID `json:"id"`
+	}
+	is.NoError(json.Unmarshal([]byte(`{"id":"`+string(expected)+`"}`), &record))
+	is.Equal(expected, record.ID, "An embedded ID should deserialize from a string")
+	is.Error(json.Unmarshal([]byte(`{"id":7}`), &record), "An embedded ID should reject a number")
+
+	var nilID *ID
+	is.Equal(ErrNilPointer, nilID.UnmarshalJSON([]byte(`"abc"`)), "UnmarshalJSON() on a nil ID should return ErrNilPointer")
+}
+
+// TestID_Bytes tests the Bytes() method of the ID type.
+// It verifies that Bytes() returns an independent copy of the underlying bytes.
+func TestID_Bytes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id := ID("αβγ")
+	b := id.Bytes()
+	is.Equal([]byte("αβγ"), b, "Bytes() should return the UTF-8 bytes of the ID")
+
+	b[0] = 'x'
+	is.Equal(ID("αβγ"), id, "Modifying the returned slice should not affect the ID")
+
+	var nilID *ID
+	is.Nil(nilID.Bytes(), "Bytes() on a nil ID should return nil")
+}
+
+// TestID_Len tests the Len() method of the ID type.
+// It verifies that Len() counts runes rather than bytes.
+func TestID_Len(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id := Must()
+	is.Equal(DefaultLength, id.Len(), "Len() should return the number of characters")
+
+	gen, err := NewGenerator(WithAlphabet("αβγδεζηθ"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	unicodeID, err := gen.New(10)
+	is.NoError(err, "New should not return an error")
+	is.Equal(10, unicodeID.Len(), "Len() should count runes for a multibyte alphabet")
+	is.Equal(20, len(unicodeID.Bytes()), "Each character should occupy two bytes")
+
+	is.Zero(EmptyID.Len(), "Len() of EmptyID should be zero")
+
+	var nilID *ID
+	is.Zero(nilID.Len(), "Len() on a nil ID should return zero")
+}
+
+// TestID_Format tests the Format() method of the ID type.
+// It verifies the supported verbs and that width, precision and flags are honored.
+func TestID_Format(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id := ID("ab\tc")
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%s", "ab\tc"},
+		{"%v", "ab\tc"},
+		{"%q", `"ab\tc"`},
+		{"%x", "61620963"},
+		{"%X", "61620963"},
+		{"% x", "61 62 09 63"},
+		{"%8s", "    ab\tc"},
+		{"%-8s|", "ab\tc    |"},
+		{"%.2s", "ab"},
+		{"%d", "%!d(nanoid.ID=ab\tc)"},
+	}
+
+	for _, tt := range tests {
+		is.Equal(tt.expected, fmt.Sprintf(tt.format, &id), "Sprintf(%q)", tt.format)
+	}
+
+	upper := ID("z~")
+	is.Equal("7a7e", fmt.Sprintf("%x", &upper))
+	is.Equal("7A7E", fmt.Sprintf("%X", &upper))
+
+	// Formatting through a struct pointer reaches the ID via its address.
+	type record struct {
+		ID *ID
+	}
+	is.Equal(`{"ab\tc"}`, fmt.Sprintf("%q", record{ID: &id}))
+
+	var nilID *ID
+	is.Equal("<nil>", fmt.Sprintf("%s", nilID), "A nil ID should format as <nil>")
+}
+
+// TestID_Equal tests the Equal() method of the ID type.
+func TestID_Equal(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id := Must()
+	is.True(id.Equal(id), "An ID should equal itself")
+	is.True(id.Equal(ID(string(id))), "An ID should equal a copy of itself")
+	is.False(id.Equal(Must()), "Distinct IDs should not be equal")
+
+	empty := EmptyID
+	is.True(empty.Equal(EmptyID), "Two EmptyID values should be equal")
+	is.False(empty.Equal(id), "EmptyID should not equal a generated ID")
+}
+
+// TestID_HasPrefix tests the HasPrefix() method of the ID type.
+func TestID_HasPrefix(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	id := ID("usr_V1StGXR8")
+	is.True(id.HasPrefix("usr_"))
+	is.True(id.HasPrefix(""), "Every ID has the empty prefix")
+	is.True(id.HasPrefix(string(id)), "An ID has itself as a prefix")
+	is.False(id.HasPrefix("org_"))
+	is.False(id.HasPrefix("usr_V1StGXR8_"), "A prefix longer than the ID should not match")
+
+	unicodeID := ID("αβγ")
+	is.True(unicodeID.HasPrefix("αβ"), "HasPrefix should work with multibyte characters")
+}
+
+// TestID_AppendText tests the AppendText() method of the ID type.
+// It verifies that AppendText() mirrors MarshalText() and appends without allocating.
+func TestID_AppendText(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// encoding.TextAppender is declared locally to keep the module buildable on Go 1.23.
+	var _ interface {
+		AppendText(b []byte) ([]byte, error)
+	} = (*ID)(nil)
+
+	id := Must()
+	text, err := id.MarshalText()
+	is.NoError(err)
+
+	appended, err := id.AppendText([]byte("id="))
+	is.NoError(err, "AppendText() should not return an error")
+	is.Equal("id="+string(text), string(appended), "AppendText() should append the same bytes MarshalText() returns")
+
+	appended, err = id.AppendText(nil)
+	is.NoError(err, "AppendText() should accept a nil buffer")
+	is.Equal(text, appended)
+
+	var nilID *ID
+	prefix := []byte("id=")
+	appended, err = nilID.AppendText(prefix)
+	is.Equal(ErrNilPointer, err, "AppendText() on a nil ID should return ErrNilPointer")
+	is.Equal(prefix, appended, "AppendText() on a nil ID should return the buffer unchanged")
+}
+
+// TestID_AppendTextAllocations tests that AppendText does not allocate when the buffer has capacity.
+func TestID_AppendTextAllocations(t *testing.T) {
+	is := assert.New(t)
+
+	id := Must()
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = id.AppendText(buf[:0])
+	})
+	is.Zero(allocs, "AppendText() should not allocate when the buffer has capacity")
+}
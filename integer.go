@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math/big"
+)
+
+// IntegerCodec is implemented by generators that can encode unsigned integers into their alphabet
+// and back.
+type IntegerCodec interface {
+	// EncodeUint64 encodes an unsigned integer in the base of the alphabet, left-padded to the
+	// larger of minLength and the configured zero padding width. DecodeUint64 reverses it.
+	//
+	// Usage:
+	//   id, err := generator.EncodeUint64(42, 0)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Encoded:", id)
+	EncodeUint64(value uint64, minLength int) (ID, error)
+
+	// DecodeUint64 decodes an ID produced by EncodeUint64 back into the original value.
+	//
+	// Usage:
+	//   value, err := generator.DecodeUint64(id)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Value:", value)
+	DecodeUint64(id ID) (uint64, error)
+}
+
+// uint64Bits is the number of bits in a uint64.
+const uint64Bits = 64
+
+// EncodeUint64 encodes an unsigned integer in the base of the generator's alphabet,
+// most significant digit first, so that it can be decoded back with DecodeUint64.
+//
+// The result is left-padded with the alphabet's first character to the larger of minLength
+// and the generator's zero padding width (see WithZeroPadding), and is always at least one
+// character long. With a numeric alphabet such as "0123456789", this yields conventional
+// zero-padded decimal strings.
+//
+// Parameters:
+//   - value uint64: The value to encode.
+//   - minLength int: The minimum number of characters in the result. Zero uses the generator's padding width.
+//
+// Returns:
+//   - ID: The encoded value.
+//   - error: An error object if the encoding fails.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if minLength is negative.
+//
+// Usage Example:
+//
+//	gen, _ := nanoid.NewGenerator(nanoid.WithAlphabet("0123456789"), nanoid.WithZeroPadding(6))
+//	id, err := gen.EncodeUint64(42, 0)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Encoded:", id) // Output: 000042
+func (g *generator) EncodeUint64(value uint64, minLength int) (ID, error) {
+	if minLength < 0 {
+		return EmptyID, ErrInvalidLength
+	}
+
+	width := max(minLength, g.config.zeroPadding, 1)
+	return encodeBig(new(big.Int).SetUint64(value), g.config.runeAlphabet, width), nil
+}
+
+// DecodeUint64 decodes an ID produced by EncodeUint64 back into the original value.
+// Leading padding characters are accepted regardless of the configured padding width.
+//
+// Parameters:
+//   - id ID: The encoded value.
+//
+// Returns:
+//   - uint64: The decoded value.
+//   - error: An error object if the ID is not a valid encoded value.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if the ID is empty.
+//   - ErrInvalidCharacter: Returned if the ID contains a character outside the alphabet.
+//   - ErrValueOutOfRange: Returned if the decoded value exceeds 64 bits.
+//
+// Usage Example:
+//
+//	value, err := gen.DecodeUint64(id)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Value:", value)
+func (g *generator) DecodeUint64(id ID) (uint64, error) {
+	if id.IsEmpty() {
+		return 0, ErrInvalidLength
+	}
+
+	n, err := decodeBig(id, int(g.config.alphabetLen), g.config.runeIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	if n.BitLen() > uint64Bits {
+		return 0, ErrValueOutOfRange
+	}
+
+	return n.Uint64(), nil
+}
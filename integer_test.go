@@ -0,0 +1,100 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_EncodeUint64ZeroPadding tests encoding small values with a configured padding width.
+func TestGenerator_EncodeUint64ZeroPadding(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithAlphabet("0123456789"), WithZeroPadding(6))
+	is.NoError(err, "NewGenerator() should not return an error with zero padding")
+	is.Equal(6, gen.(Configuration).Config().(ExtendedConfig).ZeroPadding(), "Config should report the padding width")
+
+	tests := []struct {
+		value     uint64
+		minLength int
+		expected  ID
+	}{
+		{0, 0, "000000"},
+		{7, 0, "000007"},
+		{42, 0, "000042"},
+		{123456, 0, "123456"},
+		{1234567, 0, "1234567"},
+		{42, 3, "000042"},
+		{42, 8, "00000042"},
+	}
+
+	for _, tt := range tests {
+		id, err := gen.(IntegerCodec).EncodeUint64(tt.value, tt.minLength)
+		is.NoError(err, "EncodeUint64 should not return an error")
+		is.Equal(tt.expected, id, "EncodeUint64(%d, %d) should be padded", tt.value, tt.minLength)
+
+		value, err := gen.(IntegerCodec).DecodeUint64(id)
+		is.NoError(err, "DecodeUint64 should not return an error")
+		is.Equal(tt.value, value, "DecodeUint64 should round-trip the value")
+	}
+}
+
+// TestGenerator_EncodeUint64 tests encoding without padding and round-tripping boundary values.
+func TestGenerator_EncodeUint64(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.Equal(0, gen.(Configuration).Config().(ExtendedConfig).ZeroPadding(), "Padding should be disabled by default")
+
+	id, err := gen.(IntegerCodec).EncodeUint64(0, 0)
+	is.NoError(err, "EncodeUint64 should not return an error")
+	is.Equal(ID(DefaultAlphabet[:1]), id, "Zero should encode as a single character")
+
+	for _, value := range []uint64{1, 63, 64, 1 << 32, math.MaxUint64} {
+		id, err = gen.(IntegerCodec).EncodeUint64(value, 0)
+		is.NoError(err, "EncodeUint64 should not return an error")
+
+		decoded, err := gen.(IntegerCodec).DecodeUint64(id)
+		is.NoError(err, "DecodeUint64 should not return an error")
+		is.Equal(value, decoded, "DecodeUint64 should round-trip %d", value)
+	}
+
+	_, err = gen.(IntegerCodec).EncodeUint64(1, -1)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a negative minimum length")
+}
+
+// TestGenerator_DecodeUint64Errors tests decoding invalid input.
+func TestGenerator_DecodeUint64Errors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithAlphabet("0123456789"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.(IntegerCodec).DecodeUint64(EmptyID)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for an empty ID")
+
+	_, err = gen.(IntegerCodec).DecodeUint64("12a4")
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a character outside the alphabet")
+
+	_, err = gen.(IntegerCodec).DecodeUint64("18446744073709551616")
+	is.Equal(ErrValueOutOfRange, err, "Expected ErrValueOutOfRange for a value above MaxUint64")
+}
+
+// TestWithZeroPaddingInvalid tests that a negative padding width is rejected.
+func TestWithZeroPaddingInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := NewGenerator(WithZeroPadding(-1))
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a negative padding width")
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// LengthOf recovers the body length encoded in the leading character of an ID generated with
+// WithLengthPrefix.
+//
+// The configured region code, if any, is skipped before the length character is read. The body
+// is not inspected; use ValidateStrict to confirm the ID actually has the encoded length.
+//
+// Parameters:
+//   - id ID: The ID to inspect.
+//
+// Returns:
+//   - int: The number of random characters following the length prefix.
+//   - error: An error object if the length cannot be recovered.
+//
+// Error Conditions:
+//   - ErrNoLengthPrefix: Returned if the generator has no length prefix configured.
+//   - ErrInvalidAffix: Returned if the ID does not carry the configured prefix and suffix.
+//   - ErrVersionMismatch: Returned if the ID does not carry the configured version character.
+//   - ErrInvalidRegionCode: Returned if the ID does not start with the configured region code.
+//   - ErrInvalidLength: Returned if the ID is too short to contain a length prefix.
+//   - ErrInvalidCharacter: Returned if the length character is outside the alphabet.
+//
+// Usage Example:
+//
+//	n, err := generator.LengthOf(id)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Body length:", n)
+func (g *generator) LengthOf(id ID) (int, error) {
+	if !g.config.isLengthPrefixed {
+		return 0, ErrNoLengthPrefix
+	}
+
+	s, err := g.trimFixed(string(id))
+	if err != nil {
+		return 0, err
+	}
+
+	if g.config.regionCode != "" {
+		if !strings.HasPrefix(s, g.config.regionCode) {
+			return 0, ErrInvalidRegionCode
+		}
+		s = s[len(g.config.regionCode):]
+	}
+
+	if s == "" {
+		return 0, ErrInvalidLength
+	}
+
+	r, _ := utf8.DecodeRuneInString(s)
+	n, ok := g.config.runeIndex[r]
+	if !ok {
+		return 0, ErrInvalidCharacter
+	}
+
+	return n, nil
+}
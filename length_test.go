@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithLengthPrefix tests round-tripping body lengths through the length prefix.
+func TestWithLengthPrefix(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithLengthPrefix(true))
+	is.NoError(err, "NewGenerator() should not return an error with a length prefix")
+	is.True(gen.(Configuration).Config().(ExtendedConfig).HasLengthPrefix(), "Config.HasLengthPrefix should be true")
+
+	for _, length := range []int{1, 8, DefaultLength, 63} {
+		id, err := gen.New(length)
+		is.NoError(err, "New(%d) should not return an error", length)
+		is.Len(id, length+1, "The ID should include the length character")
+		is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+
+		n, err := gen.(Inspector).LengthOf(id)
+		is.NoError(err, "LengthOf should not return an error")
+		is.Equal(length, n, "LengthOf should recover the body length")
+		is.NoError(gen.(Validator).ValidateStrict(id, length), "ValidateStrict should accept the ID")
+		is.Equal(ErrLengthMismatch, gen.(Validator).ValidateStrict(id[1:], length), "A missing prefix should be rejected")
+	}
+
+	_, err = gen.New(64)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength beyond the longest encodable length")
+
+	id, err := gen.(MaxBytesGenerator).NewMaxBytes(100)
+	is.NoError(err, "NewMaxBytes should clamp to the longest encodable length")
+	is.Len(id, 64)
+}
+
+// TestWithLengthPrefixRegionCode tests that the length prefix follows the region code.
+func TestWithLengthPrefixRegionCode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithLengthPrefix(true), WithRegionCode("eu"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id, err := gen.New(10)
+	is.NoError(err, "New should not return an error")
+	is.Len(id, 13, "The ID should include the region code and length character")
+
+	n, err := gen.(Inspector).LengthOf(id)
+	is.NoError(err, "LengthOf should not return an error")
+	is.Equal(10, n)
+
+	_, err = gen.(Inspector).LengthOf("us" + id[2:])
+	is.Equal(ErrInvalidRegionCode, err, "Expected ErrInvalidRegionCode for a foreign region")
+
+	_, err = gen.(Inspector).LengthOf("eu")
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for an ID without a length character")
+
+	_, err = gen.(Inspector).LengthOf("eu!")
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a length character outside the alphabet")
+}
+
+// TestLengthOfWithoutPrefix tests LengthOf on a generator without a length prefix.
+func TestLengthOfWithoutPrefix(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := Generator.(Inspector).LengthOf("abc")
+	is.Equal(ErrNoLengthPrefix, err, "Expected ErrNoLengthPrefix")
+}
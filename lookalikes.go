@@ -0,0 +1,42 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+)
+
+// lookalikes lists the characters that are easily mistaken for one another when read or
+// handwritten: 1/l/I, 0/O/o, u/v, 5/S/s and 2/Z.
+const lookalikes = "1lI0Oouv5Ss2Z"
+
+// RemoveLookalikes returns the alphabet with visually confusable characters removed, such as
+// 0 and O, or 1, l and I, preserving the order of the remaining characters and dropping
+// duplicates. Characters outside the confusable set, including non-ASCII ones, are kept.
+//
+// The result may be too short for NewGenerator if the alphabet consisted mostly of
+// confusable characters.
+//
+// Parameters:
+//   - alphabet string: The alphabet to filter.
+//
+// Returns:
+//   - string: The filtered alphabet.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewGenerator(
+//	    nanoid.WithAlphabet(nanoid.RemoveLookalikes(nanoid.AlphabetBase58)))
+func RemoveLookalikes(alphabet string) string {
+	runes := make([]rune, 0, len(alphabet))
+	for _, r := range alphabet {
+		if !strings.ContainsRune(lookalikes, r) {
+			runes = append(runes, r)
+		}
+	}
+
+	return string(dedupRunes(runes))
+}
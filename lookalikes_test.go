@@ -0,0 +1,46 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRemoveLookalikes tests that no confusable characters survive and order and uniqueness are preserved.
+func TestRemoveLookalikes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, alphabet := range []string{DefaultAlphabet, AlphabetBase58, AlphabetBase62, AlphabetBase32} {
+		filtered := RemoveLookalikes(alphabet)
+		is.False(strings.ContainsAny(filtered, lookalikes), "No lookalike should survive in %q", filtered)
+
+		_, err := NewGenerator(WithAlphabet(filtered))
+		is.NoError(err, "The filtered alphabet should be valid")
+	}
+
+	is.Equal(AlphabetNoLookalikes, RemoveLookalikes(AlphabetBase62), "AlphabetNoLookalikes should be Base62 without lookalikes")
+	is.Equal("abcæ", RemoveLookalikes("a1bOcbæa"), "Order should be preserved and duplicates dropped")
+	is.Empty(RemoveLookalikes("0O1lI"), "An alphabet of lookalikes should be emptied")
+}
+
+// TestAlphabetNoLookalikes tests that the preset is a valid alphabet without confusable characters.
+func TestAlphabetNoLookalikes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.False(strings.ContainsAny(AlphabetNoLookalikes, lookalikes), "The preset should contain no lookalikes")
+
+	gen, err := NewGenerator(WithAlphabet(AlphabetNoLookalikes))
+	is.NoError(err, "NewGenerator() should accept the preset")
+
+	id, err := gen.New(DefaultLength)
+	is.NoError(err, "New should not return an error")
+	is.True(isValidID(id, AlphabetNoLookalikes), "Generated ID contains invalid characters")
+}
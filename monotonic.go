@@ -0,0 +1,150 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"slices"
+	"sync"
+)
+
+// monotonicState tracks the most recently issued ID of each length so that successive IDs
+// from a generator configured with WithMonotonic strictly increase in lexicographic order.
+type monotonicState struct {
+	mu   sync.Mutex
+	last map[int]ID
+
+	// sorted and rank describe the alphabet in code point order, which matches the
+	// byte-wise lexicographic order of UTF-8 strings.
+	sorted []rune
+	rank   map[rune]int
+
+	// leadingSorted and leadingRank describe the leading alphabet, if one is configured,
+	// which applies to the character at leadingPos.
+	leadingSorted []rune
+	leadingRank   map[rune]int
+	leadingPos    int
+
+	// maxAttempts bounds the increments tried when successors are rejected.
+	maxAttempts int
+}
+
+// monotonicTimePrefixBytes is the width of the timestamp used by NewMonotonicGenerator,
+// enough to represent dates until the year 10889.
+const monotonicTimePrefixBytes = 6
+
+// NewMonotonicGenerator creates a generator whose IDs strictly increase within the process,
+// in the style of ULIDs: each ID starts with its creation time in milliseconds (see
+// WithTimePrefix), followed by random characters.
+//
+// When an ID would not sort after the previous ID of the same length, for example because both
+// were requested in the same millisecond, the previous ID is incremented by one instead. The
+// increment treats the whole ID, timestamp included, as a number, so when the random part is
+// exhausted it rolls over into the timestamp rather than failing. The state is guarded by a
+// mutex, so the generator is safe for concurrent use; IDs issued to concurrent callers are
+// ordered by the time each call acquires the lock.
+//
+// The options are applied on top of a six-byte time prefix and WithMonotonic(true); a caller
+// may supply WithTimePrefix to change the timestamp width, but monotonicity cannot be disabled.
+//
+// Parameters:
+//   - opts ...Option: Additional configuration options, such as WithAlphabet or WithRandReader.
+//
+// Returns:
+//   - Interface: A generator producing strictly increasing IDs.
+//   - error: An error object if the generator could not be created.
+//
+// Usage Example:
+//
+//	generator, err := nanoid.NewMonotonicGenerator()
+//	if err != nil {
+//	    // handle error
+//	}
+//	id, err := generator.New(26) // An 8-character timestamp followed by 18 random characters.
+func NewMonotonicGenerator(opts ...Option) (Interface, error) {
+	options := make([]Option, 0, len(opts)+2)
+	options = append(options, WithTimePrefix(monotonicTimePrefixBytes))
+	options = append(options, opts...)
+	options = append(options, WithMonotonic(true))
+
+	return NewGenerator(options...)
+}
+
+// newMonotonicState builds the sorted alphabet views used to increment IDs. The leading
+// alphabet, if any, applies to the character at leadingPos.
+func newMonotonicState(alphabet, leading []rune, leadingPos, maxAttempts int) *monotonicState {
+	m := &monotonicState{
+		last:        make(map[int]ID),
+		leadingPos:  leadingPos,
+		maxAttempts: maxAttempts,
+	}
+	m.sorted, m.rank = sortedAlphabet(alphabet)
+	if leading != nil {
+		m.leadingSorted, m.leadingRank = sortedAlphabet(leading)
+	}
+	return m
+}
+
+// sortedAlphabet returns a copy of alphabet sorted by code point and each rune's rank within it.
+func sortedAlphabet(alphabet []rune) ([]rune, map[rune]int) {
+	sorted := slices.Clone(alphabet)
+	slices.Sort(sorted)
+
+	rank := make(map[rune]int, len(sorted))
+	for i, r := range sorted {
+		rank[r] = i
+	}
+	return sorted, rank
+}
+
+// next returns the ID to issue given a freshly generated candidate of the specified length.
+//
+// If the candidate does not sort after the previously issued ID of the same length, the previous
+// ID is incremented by one in the sorted alphabet instead, skipping values rejected by accept.
+func (m *monotonicState) next(candidate ID, length int, accept func(ID) bool) (ID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	last, ok := m.last[length]
+	if ok && candidate <= last {
+		var err error
+		candidate = last
+		for attempts := 0; ; attempts++ {
+			if attempts == m.maxAttempts {
+				return EmptyID, ErrExceededMaxAttempts
+			}
+			if candidate, err = m.increment(candidate); err != nil {
+				return EmptyID, err
+			}
+			if accept(candidate) {
+				break
+			}
+		}
+	}
+
+	m.last[length] = candidate
+	return candidate, nil
+}
+
+// increment returns the smallest ID of the same length that sorts after id, treating each
+// character as a digit in the sorted alphabet (or sorted leading alphabet at leadingPos).
+func (m *monotonicState) increment(id ID) (ID, error) {
+	runes := []rune(string(id))
+	for i := len(runes) - 1; i >= 0; i-- {
+		sorted, rank := m.sorted, m.rank
+		if i == m.leadingPos && m.leadingSorted != nil {
+			sorted, rank = m.leadingSorted, m.leadingRank
+		}
+
+		k := rank[runes[i]]
+		if k+1 < len(sorted) {
+			runes[i] = sorted[k+1]
+			return ID(runes), nil
+		}
+		runes[i] = sorted[0]
+	}
+
+	return EmptyID, ErrMonotonicOverflow
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithMonotonic tests that successive IDs strictly increase in sort order.
+func TestWithMonotonic(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithMonotonic(true))
+	is.NoError(err, "NewGenerator() should not return an error with monotonic mode")
+	is.True(gen.(Configuration).Config().(ExtendedConfig).IsMonotonic(), "Config.IsMonotonic should be true")
+
+	var previous ID
+	for i := 0; i < 10000; i++ {
+		id, err := gen.New(DefaultLength)
+		is.NoError(err, "New should not return an error")
+		is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+		if i > 0 && !is.Equal(1, id.Compare(previous), "ID %q should sort after %q", id, previous) {
+			return
+		}
+		previous = id
+	}
+}
+
+// TestWithMonotonicIncrement tests that ties are resolved by incrementing and overflow is reported.
+func TestWithMonotonicIncrement(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// The reader always yields the smallest ID, forcing every subsequent ID to be an increment.
+	gen, err := NewGenerator(
+		WithAlphabet("10"),
+		WithRandReader(&cyclicReader{data: []byte{1}}),
+		WithMonotonic(true),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with monotonic mode")
+
+	expected := []ID{"000", "001", "010", "011", "100", "101", "110", "111"}
+	for _, want := range expected {
+		id, err := gen.New(3)
+		is.NoError(err, "New should not return an error")
+		is.Equal(want, id, "Monotonic IDs should increment in code point order")
+	}
+
+	_, err = gen.New(3)
+	is.Equal(ErrMonotonicOverflow, err, "Expected ErrMonotonicOverflow once the sequence is exhausted")
+
+	// Each length keeps its own sequence.
+	id, err := gen.New(2)
+	is.NoError(err, "New should not return an error for a different length")
+	is.Equal(ID("00"), id, "A new length should start its own sequence")
+}
+
+// TestWithMonotonicConcurrent tests that monotonic generation is safe for concurrent use.
+func TestWithMonotonicConcurrent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithMonotonic(true))
+	is.NoError(err, "NewGenerator() should not return an error with monotonic mode")
+
+	const (
+		goroutines = 8
+		perRoutine = 500
+	)
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		ids = make(map[ID]struct{}, goroutines*perRoutine)
+	)
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var previous ID
+			for j := 0; j < perRoutine; j++ {
+				id, err := gen.New(DefaultLength)
+				if !is.NoError(err, "New should not return an error") {
+					return
+				}
+				is.True(id > previous, "IDs observed by a goroutine should strictly increase")
+				previous = id
+
+				mu.Lock()
+				ids[id] = struct{}{}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	is.Len(ids, goroutines*perRoutine, "Monotonic IDs should be unique")
+}
+
+// TestNewMonotonicGenerator tests strict monotonicity under parallel callers.
+func TestNewMonotonicGenerator(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewMonotonicGenerator()
+	is.NoError(err, "NewMonotonicGenerator() should not return an error")
+
+	config := gen.(Configuration).Config().(ExtendedConfig)
+	is.True(config.IsMonotonic(), "Config.IsMonotonic should be true")
+	is.Equal(8, config.TimePrefixWidth(), "A six-byte timestamp should take 8 characters")
+
+	const (
+		goroutines = 8
+		perRoutine = 500
+	)
+
+	var (
+		wg      sync.WaitGroup
+		results = make([][]ID, goroutines)
+	)
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < perRoutine; j++ {
+				id, err := gen.New(16)
+				if !is.NoError(err, "New should not return an error") {
+					return
+				}
+				results[i] = append(results[i], id)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[ID]struct{}, goroutines*perRoutine)
+	for _, ids := range results {
+		for j, id := range ids {
+			if j > 0 {
+				is.Negative(ids[j-1].Compare(id), "Each caller should see strictly increasing IDs")
+			}
+			seen[id] = struct{}{}
+		}
+	}
+	is.Len(seen, goroutines*perRoutine, "IDs issued to parallel callers should be distinct")
+}
+
+// TestNewMonotonicGeneratorRollover tests that an exhausted random part rolls over into the timestamp.
+func TestNewMonotonicGeneratorRollover(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// A reader of 0xFF bytes always draws the largest character, so every candidate after the
+	// first is rejected and the random part is already at its maximum.
+	gen, err := NewMonotonicGenerator(
+		WithAlphabet("0123456789abcdef"),
+		WithRandReader(&cyclicReader{data: []byte{0xFF}}),
+	)
+	is.NoError(err, "NewMonotonicGenerator() should not return an error")
+
+	prev, err := gen.New(14)
+	is.NoError(err, "New should not return an error")
+	is.Equal(ID("ff"), prev[12:], "The random part should be at its maximum")
+
+	// Calls within the same millisecond cannot draw a larger random part, so they must carry.
+	rolledOver := false
+	for i := 0; i < 100; i++ {
+		id, err := gen.New(14)
+		is.NoError(err, "New should roll over into the timestamp instead of failing")
+		is.Negative(prev.Compare(id), "Each ID should sort after the previous one")
+		rolledOver = rolledOver || id[12:] == "00"
+		prev = id
+	}
+	is.True(rolledOver, "The random part should wrap to its minimum when carried into the timestamp")
+}
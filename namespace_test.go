@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"fmt"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// firstRunes counts the first character of n namespaced IDs.
+func firstRunes(t *testing.T, gen Interface, namespace string, n int) map[rune]int {
+	t.Helper()
+	counts := make(map[rune]int)
+	for i := 0; i < n; i++ {
+		id, err := gen.(NamespacedGenerator).NewNamespaced(namespace, 8)
+		if !assert.NoError(t, err, "NewNamespaced should not return an error") {
+			return counts
+		}
+		r, _ := utf8.DecodeRuneInString(string(id))
+		counts[r]++
+	}
+	return counts
+}
+
+// TestGenerator_NewNamespaced tests that a namespace consistently biases its IDs
+// toward a region that differs from another namespace's.
+func TestGenerator_NewNamespaced(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	const samples = 200
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	counts := firstRunes(t, gen, "example.com", samples)
+	is.Len(counts, 1, "Every ID in a namespace should share its first character")
+
+	var first rune
+	for r := range counts {
+		first = r
+	}
+
+	// Find another namespace that maps to a different region of the alphabet.
+	var other map[rune]int
+	for i := 0; i < 100 && other == nil; i++ {
+		c := firstRunes(t, gen, fmt.Sprintf("tenant-%d", i), samples)
+		if _, shared := c[first]; !shared {
+			other = c
+		}
+	}
+	is.NotNil(other, "Some namespace should map to a different first character")
+	is.Len(other, 1, "Every ID in the other namespace should share its first character")
+
+	// Only the first character is biased; the rest remains random.
+	id, err := gen.(NamespacedGenerator).NewNamespaced("example.com", 21)
+	is.NoError(err, "NewNamespaced should not return an error")
+	is.Len(id, 21)
+	is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+}
+
+// TestGenerator_NewNamespacedConstrained tests namespaced generation with decorations,
+// a leading alphabet and a Unicode alphabet.
+func TestGenerator_NewNamespacedConstrained(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(
+		WithAlphabet("abcdefghijklmnopqrstuvwxyz0123456789"),
+		WithLeadingAlphabet("abcdef"),
+		WithRegionCode("eu"),
+	)
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	a, err := gen.(NamespacedGenerator).NewNamespaced("acme", 10)
+	is.NoError(err, "NewNamespaced should not return an error")
+	b, err := gen.(NamespacedGenerator).NewNamespaced("acme", 10)
+	is.NoError(err, "NewNamespaced should not return an error")
+	is.NoError(gen.(Validator).ValidateStrict(a, 10), "Namespaced IDs should conform to the scheme")
+	is.Equal(a[:3], b[:3], "IDs in a namespace should share the region code and first character")
+
+	unicodeGen, err := NewGenerator(WithUnicodeRange(0x0370, 0x03FF, "L"))
+	is.NoError(err, "NewGenerator() should not return an error with a Unicode range")
+
+	counts := firstRunes(t, unicodeGen, "acme", 50)
+	is.Len(counts, 1, "Unicode alphabets should also select a consistent first character")
+
+	_, err = gen.(NamespacedGenerator).NewNamespaced("acme", 0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a zero length")
+}
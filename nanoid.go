@@ -8,7 +8,13 @@ package nanoid
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
+	"math"
+	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
+	"unsafe"
 
 	"github.com/sixafter/nanoid/x/crypto/prng"
 )
@@ -30,18 +36,51 @@ const (
 	// Example: "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	DefaultAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
+	// AlphabetBase58 is the Bitcoin base58 alphabet. It omits 0, O, I and l, which are easily
+	// confused with one another.
+	AlphabetBase58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// AlphabetBase62 consists of the digits followed by the uppercase and lowercase English letters.
+	AlphabetBase62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	// AlphabetBase32 is the standard base32 alphabet of RFC 4648, section 6.
+	AlphabetBase32 = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+
+	// AlphabetBase32Hex is the "extended hex" base32 alphabet of RFC 4648, section 7, which
+	// preserves the sort order of the encoded data.
+	AlphabetBase32Hex = "0123456789ABCDEFGHIJKLMNOPQRSTUV"
+
+	// AlphabetCrockfordBase32 is Douglas Crockford's base32 alphabet, which excludes I, L, O and U
+	// to avoid visual ambiguity and accidental obscenity.
+	AlphabetCrockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// AlphabetNoLookalikes is AlphabetBase62 with visually confusable characters removed (see
+	// RemoveLookalikes), for IDs that people read aloud or type, such as coupon codes.
+	AlphabetNoLookalikes = "346789ABCDEFGHJKLMNPQRTUVWXYabcdefghijkmnpqrtwxyz"
+
+	// AlphabetHexLower is the lowercase hexadecimal alphabet.
+	AlphabetHexLower = "0123456789abcdef"
+
+	// AlphabetHexUpper is the uppercase hexadecimal alphabet.
+	AlphabetHexUpper = "0123456789ABCDEF"
+
 	// DefaultLength specifies the default number of characters in a generated Nano ID.
 	// A length of 21 characters provides a high level of uniqueness while maintaining
 	// brevity, making it suitable for most applications requiring unique identifiers.
 	DefaultLength = 21
 
-	// maxAttemptsMultiplier determines the maximum number of attempts the generator
-	// will make to produce a valid Nano ID before failing. It is calculated as a
-	// multiplier based on the desired ID length to balance between performance
-	// and the probability of successful ID generation, especially when using
+	// maxAttemptsMultiplier is the default maximum number of attempts the generator
+	// will make to produce a valid Nano ID before failing (see WithMaxAttempts). It is
+	// calculated as a multiplier based on the desired ID length to balance between
+	// performance and the probability of successful ID generation, especially when using
 	// non-power-of-two alphabets.
 	maxAttemptsMultiplier = 10
 
+	// minSamplingLength is the fewest characters the sampling budget is sized for. Without it,
+	// a one-character ID from an alphabet that rejects nearly half of all random values would
+	// exhaust a budget of 10 attempts about once in a thousand calls.
+	minSamplingLength = 4
+
 	// MinAlphabetLength sets the minimum permissible number of unique characters
 	// in the alphabet used for Nano ID generation. An alphabet with fewer than
 	// 2 characters would not provide sufficient variability for generating unique IDs,
@@ -70,6 +109,14 @@ func init() {
 //
 // Implementations of this interface provide methods to create new IDs
 // and to read random data, supporting both ID generation and direct random byte access.
+//
+// Further capabilities are defined by small optional interfaces, such as BatchGenerator,
+// Validator and Configuration, so that Interface stays easy to implement and mock. Generators
+// created by this package implement all of them; callers obtain one with a type assertion:
+//
+//	if b, ok := generator.(nanoid.BatchGenerator); ok {
+//	    ids, err := b.NewN(100, 21)
+//	}
 type Interface interface {
 	// New generates and returns a new Nano ID as a string with the specified length.
 	// The 'length' parameter determines the number of characters in the generated ID.
@@ -99,10 +146,64 @@ type Interface interface {
 	Read(b []byte) (n int, err error)
 }
 
+// BufferGenerator is implemented by generators that can generate IDs into caller-owned buffers,
+// avoiding per-ID allocations.
+type BufferGenerator interface {
+	// NewInto generates a new Nano ID of the specified length into the caller-owned buffer *buf,
+	// growing it if needed, and returns an ID that aliases the buffer's memory.
+	// The returned ID is only valid until *buf is next modified; copy it to retain it.
+	// Once the buffer has grown to fit, steady-state generation performs no allocations.
+	//
+	// Usage:
+	//   buf := make([]byte, 0, 21)
+	//   id, err := generator.NewInto(&buf, 21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Generated ID:", id)
+	NewInto(buf *[]byte, length int) (ID, error)
+
+	// NewIntoBytes writes a new Nano ID of the specified length into the caller-owned slice dst,
+	// which must be at least 'length' bytes long, and returns the number of bytes written.
+	// Only ASCII alphabets are supported. Nothing is allocated for plain generators.
+	//
+	// Usage:
+	//   buf := make([]byte, 21)
+	//   n, err := generator.NewIntoBytes(buf, 21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Generated ID:", string(buf[:n]))
+	NewIntoBytes(dst []byte, length int) (int, error)
+}
+
+// MaxBytesGenerator is implemented by generators that can generate IDs bounded by a byte budget
+// rather than a character count.
+type MaxBytesGenerator interface {
+	// NewMaxBytes generates the longest Nano ID whose UTF-8 encoding always fits within maxBytes,
+	// based on the alphabet's MaxBytesPerRune.
+	//
+	// Usage:
+	//   id, err := generator.NewMaxBytes(64)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Generated ID:", id)
+	NewMaxBytes(maxBytes int) (ID, error)
+}
+
 type generator struct {
 	config      *runtimeConfig
 	entropyPool *sync.Pool
 	idPool      *sync.Pool
+	leading     *generator
+	bloom       *bloomFilter
+	recent      *recentBuffer
+	issuedMu    sync.Mutex
+	monotonic   *monotonicState
+	sequence    sequence
+	filters     []func(ID) bool
+	plain       bool
 }
 
 // New generates a new Nano ID using the default length specified by `DefaultLength`.
@@ -220,7 +321,7 @@ func Read(b []byte) (n int, err error) {
 //   - error: An error object if the Interface could not be created due to invalid configuration.
 //
 // Error Conditions:
-//   - ErrInvalidLength: Returned if the provided LengthHint is less than 1.
+//   - ErrInvalidLength: Returned if the provided LengthHint or MinLength is less than 1.
 //   - ErrNilRandReader: Returned if the provided RandReader is nil.
 //   - ErrInvalidAlphabet: Returned if the alphabet is invalid or contains invalid UTF-8 characters.
 //   - ErrNonUTF8Alphabet: Returned if the alphabet contains non-UTF-8 characters.
@@ -230,9 +331,11 @@ func NewGenerator(options ...Option) (Interface, error) {
 	// These defaults include the default alphabet, the default random reader,
 	// and the default length hint for ID generation.
 	configOpts := &ConfigOptions{
-		Alphabet:   DefaultAlphabet,
-		RandReader: RandReader,
-		LengthHint: DefaultLength,
+		Alphabet:    DefaultAlphabet,
+		RandReader:  RandReader,
+		LengthHint:  DefaultLength,
+		MinLength:   1,
+		MaxAttempts: maxAttemptsMultiplier,
 	}
 
 	// Apply provided options to customize the configuration.
@@ -247,6 +350,23 @@ func NewGenerator(options ...Option) (Interface, error) {
 		return nil, ErrInvalidLength
 	}
 
+	// Ensure MinLength is within valid bounds.
+	// A minimum below 1 would permit empty IDs.
+	if configOpts.MinLength < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	// Ensure the Bloom filter, when enabled, has a usable size and hash count.
+	if (configOpts.BloomFilterBits != 0 || configOpts.BloomFilterHashes != 0) &&
+		(configOpts.BloomFilterBits < 1 || configOpts.BloomFilterHashes < 1) {
+		return nil, ErrInvalidBloomFilter
+	}
+
+	// Ensure the recent buffer, when enabled, has a usable size.
+	if configOpts.RecentBufferSize < 0 {
+		return nil, ErrInvalidCount
+	}
+
 	// Ensure RandReader is not nil.
 	// A valid randomness source is essential for generating secure IDs.
 	if configOpts.RandReader == nil {
@@ -287,14 +407,63 @@ func NewGenerator(options ...Option) (Interface, error) {
 		}
 	}
 
-	// Return the configured Interface instance.
-	// The generator holds references to the runtime configuration and buffer pools,
-	// facilitating efficient and thread-safe ID generation.
-	return &generator{
+	g := &generator{
 		config:      config,
 		entropyPool: entropyPool,
 		idPool:      idPool,
-	}, nil
+		filters:     configOpts.filters,
+	}
+
+	if config.isMixedCase {
+		g.filters = append(g.filters, hasMixedCase)
+	}
+
+	if config.isNoRepeats {
+		g.filters = append(g.filters, hasNoConsecutiveRepeats)
+	}
+
+	// Build a nested generator for the leading character, if one is configured.
+	// It shares the random reader but uses its own alphabet.
+	if configOpts.LeadingAlphabet != "" {
+		leading, err := NewGenerator(
+			WithAlphabet(configOpts.LeadingAlphabet),
+			WithAlphabetDedup(configOpts.AlphabetDedup),
+			WithRandReader(configOpts.RandReader),
+			WithLengthHint(1),
+			WithMaxAttempts(configOpts.MaxAttempts),
+		)
+		if err != nil {
+			return nil, err
+		}
+		g.leading = leading.(*generator)
+	}
+
+	if config.isMonotonic {
+		var leading []rune
+		if g.leading != nil {
+			leading = g.leading.config.runeAlphabet
+		}
+		g.monotonic = newMonotonicState(config.runeAlphabet, leading, config.timePrefixWidth, config.maxAttempts)
+	}
+
+	if configOpts.BloomFilterBits > 0 {
+		g.bloom = newBloomFilter(configOpts.BloomFilterBits, configOpts.BloomFilterHashes)
+	}
+
+	if configOpts.RecentBufferSize > 0 {
+		g.recent = newRecentBuffer(configOpts.RecentBufferSize)
+	}
+
+	// A plain generator emits IDs straight from the alphabet with no per-ID constraints,
+	// allowing the hot paths to skip the constrained generation logic entirely.
+	g.plain = g.leading == nil && len(g.filters) == 0 && config.regionCode == "" &&
+		config.prefix == "" && config.suffix == "" && !config.isVersioned && !config.isLengthPrefixed &&
+		config.timePrefixWidth == 0 && config.hybridPrefix == 0 && g.monotonic == nil && g.bloom == nil && g.recent == nil
+
+	// Return the configured Interface instance.
+	// The generator holds references to the runtime configuration and buffer pools,
+	// facilitating efficient and thread-safe ID generation.
+	return g, nil
 }
 
 // New generates a new Nano ID string of the specified length.
@@ -311,6 +480,7 @@ func NewGenerator(options ...Option) (Interface, error) {
 //
 // Error Conditions:
 //   - ErrInvalidLength: Returned if the provided length is less than or equal to zero.
+//   - ErrLengthTooShort: Returned if the provided length is below the configured minimum length.
 //
 // Usage Example:
 //
@@ -320,14 +490,263 @@ func NewGenerator(options ...Option) (Interface, error) {
 //	}
 //	fmt.Println("Generated ID:", id)
 func (g *generator) New(length int) (ID, error) {
+	if err := g.checkLength(length); err != nil {
+		return EmptyID, err
+	}
+
+	return g.generate(g.config.randReader, length)
+}
+
+// generate produces a complete Nano ID of a validated length using the given source of
+// randomness, applying any configured constraints and decorations.
+func (g *generator) generate(reader io.Reader, length int) (ID, error) {
+	if g.plain {
+		return g.newFrom(reader, length)
+	}
+
+	// Admitting a candidate and recording it as issued must be atomic, or concurrent callers
+	// could both admit the same candidate and return it.
+	if g.bloom != nil || g.recent != nil {
+		g.issuedMu.Lock()
+		defer g.issuedMu.Unlock()
+	}
+
+	// In the hybrid layout only the prefix is random; the rest holds the timestamp.
+	// With a time prefix, the timestamp comes first and the rest is random.
+	randomLength := length
+	if g.config.hybridPrefix > 0 {
+		randomLength = g.config.hybridPrefix
+	} else if g.config.timePrefixWidth > 0 {
+		randomLength = length - g.config.timePrefixWidth
+	}
+
+	id, err := g.newConstrained(reader, randomLength)
+	if err != nil {
+		return EmptyID, err
+	}
+
+	if g.monotonic != nil && g.config.timePrefixWidth == 0 {
+		if id, err = g.monotonic.next(id, randomLength, g.admit); err != nil {
+			return EmptyID, err
+		}
+	}
+
+	// random is the part of the ID checked by admit, and recorded once the ID is final.
+	random := id
+
+	if g.config.hybridPrefix > 0 {
+		id += g.timestampSuffix(time.Now(), length-randomLength)
+	}
+
+	if g.config.timePrefixWidth > 0 {
+		id = g.timestampPrefix(time.Now()) + id
+
+		// Ordering spans the timestamp, so an exhausted random part carries into it.
+		if g.monotonic != nil {
+			if id, err = g.monotonic.next(id, length, g.admitAfterTimePrefix); err != nil {
+				return EmptyID, err
+			}
+			random = g.afterTimePrefix(id)
+		}
+	}
+
+	g.record(random)
+
+	return g.decorate(id, length), nil
+}
+
+// decorationBytes returns the most bytes decorate may add around a generated ID body.
+func (g *generator) decorationBytes() int {
+	n := len(g.config.prefix) + len(g.config.regionCode) + len(g.config.suffix)
+	if g.config.isVersioned {
+		n += g.config.maxBytesPerRune
+	}
+	if g.config.isLengthPrefixed {
+		n += g.config.maxBytesPerRune
+	}
+	return n
+}
+
+// decorate wraps a generated ID body of the given length with the configured components,
+// such as the prefix, version, region code, length prefix and suffix.
+func (g *generator) decorate(body ID, length int) ID {
+	if g.config.isLengthPrefixed {
+		body = ID(g.config.runeAlphabet[length]) + body
+	}
+
+	head := g.config.prefix
+	if g.config.isVersioned {
+		head += string(g.config.runeAlphabet[g.config.version])
+	}
+
+	return ID(head+g.config.regionCode) + body + ID(g.config.suffix)
+}
+
+// trimAffixes strips the configured prefix and suffix from s, reporting false if s
+// does not carry both.
+func (g *generator) trimAffixes(s string) (string, bool) {
+	if len(s) < len(g.config.prefix)+len(g.config.suffix) ||
+		!strings.HasPrefix(s, g.config.prefix) || !strings.HasSuffix(s, g.config.suffix) {
+		return "", false
+	}
+
+	return s[len(g.config.prefix) : len(s)-len(g.config.suffix)], true
+}
+
+// trimFixed strips the configured prefix, version character and suffix from s, returning
+// ErrInvalidAffix or ErrVersionMismatch if s does not carry them.
+func (g *generator) trimFixed(s string) (string, error) {
+	s, ok := g.trimAffixes(s)
+	if !ok {
+		return "", ErrInvalidAffix
+	}
+
+	if !g.config.isVersioned {
+		return s, nil
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	if s == "" || r != g.config.runeAlphabet[g.config.version] {
+		return "", ErrVersionMismatch
+	}
+
+	return s[size:], nil
+}
+
+// newConstrained generates a Nano ID honoring the leading alphabet, filters and Bloom filter,
+// regenerating rejected candidates until one is admitted or the attempt budget is exhausted.
+// The returned candidate is not recorded as issued; generate does that once the ID is final.
+func (g *generator) newConstrained(reader io.Reader, length int) (ID, error) {
+	for attempts := 0; attempts < g.config.maxAttempts; attempts++ {
+		id, err := g.newCandidate(reader, length)
+		if err == ErrExceededMaxAttempts {
+			// Short candidates, such as a single leading character, can exhaust the sampling
+			// budget by chance; treat that as a rejected candidate rather than a failure.
+			continue
+		}
+		if err != nil {
+			return EmptyID, err
+		}
+
+		if g.admit(id) {
+			return id, nil
+		}
+	}
+
+	return EmptyID, ErrExceededMaxAttempts
+}
+
+// newCandidate generates a single candidate ID, drawing the first character from the
+// leading alphabet when one is configured.
+func (g *generator) newCandidate(reader io.Reader, length int) (ID, error) {
+	id, err := g.newRawCandidate(reader, length)
+	if err != nil || !g.config.isNoRepeats {
+		return id, err
+	}
+
+	return g.resampleRepeats(reader, id)
+}
+
+// newRawCandidate generates a single unconstrained candidate ID, drawing the first character
+// from the leading alphabet when one is configured.
+func (g *generator) newRawCandidate(reader io.Reader, length int) (ID, error) {
+	if g.leading == nil {
+		return g.newFrom(reader, length)
+	}
+
+	head, err := g.leading.newFrom(reader, 1)
+	if err != nil {
+		return EmptyID, err
+	}
+
+	if length == 1 {
+		return head, nil
+	}
+
+	body, err := g.newFrom(reader, length-1)
+	if err != nil {
+		return EmptyID, err
+	}
+
+	return head + body, nil
+}
+
+// accepts reports whether id satisfies every configured filter.
+func (g *generator) accepts(id ID) bool {
+	for _, filter := range g.filters {
+		if !filter(id) {
+			return false
+		}
+	}
+	return true
+}
+
+// admit reports whether id passes every filter and has not been issued before. IDs found in
+// the recent buffer, or reported by the Bloom filter as probably issued before, are rejected.
+// It has no side effects; IDs are recorded as issued by record, and generate holds issuedMu
+// across both.
+func (g *generator) admit(id ID) bool {
+	return g.accepts(id) &&
+		(g.recent == nil || !g.recent.contains(id)) &&
+		(g.bloom == nil || !g.bloom.contains(id))
+}
+
+// admitAfterTimePrefix applies admit to the random characters following the time prefix of id.
+func (g *generator) admitAfterTimePrefix(id ID) bool {
+	return g.admit(g.afterTimePrefix(id))
+}
+
+// afterTimePrefix returns the characters of id following the time prefix.
+func (g *generator) afterTimePrefix(id ID) ID {
+	return ID([]rune(string(id))[g.config.timePrefixWidth:])
+}
+
+// record notes the random part of an ID that is about to be returned in the recent buffer and
+// Bloom filter, if configured, so that later candidates repeating it are rejected.
+func (g *generator) record(id ID) {
+	if g.recent != nil {
+		g.recent.add(id)
+	}
+	if g.bloom != nil {
+		g.bloom.add(id)
+	}
+}
+
+// checkLength validates a requested ID length against the generator's policy.
+func (g *generator) checkLength(length int) error {
 	if length <= 0 {
-		return EmptyID, ErrInvalidLength
+		return ErrInvalidLength
+	}
+
+	if length < g.config.minLength {
+		return ErrLengthTooShort
 	}
 
+	if g.config.maxLength > 0 && length > g.config.maxLength {
+		return ErrInvalidLength
+	}
+
+	if g.config.hybridPrefix > 0 && length-g.config.hybridPrefix < g.config.hybridTimeWidth {
+		return ErrLengthTooShort
+	}
+
+	if g.config.timePrefixWidth > 0 && length <= g.config.timePrefixWidth {
+		return ErrLengthTooShort
+	}
+
+	if g.config.isLengthPrefixed && length >= int(g.config.alphabetLen) {
+		return ErrInvalidLength
+	}
+
+	return nil
+}
+
+// newFrom generates a new Nano ID of the specified length using the given source of randomness.
+func (g *generator) newFrom(reader io.Reader, length int) (ID, error) {
 	if g.config.isASCII {
-		return g.newASCII(length)
+		return g.newASCII(reader, length)
 	}
-	return g.newUnicode(length)
+	return g.newUnicode(reader, length)
 }
 
 // Config holds the runtime configuration for the Nano ID generator.
@@ -338,8 +757,176 @@ func (g *generator) Config() Config {
 	return g.config
 }
 
-// newASCII generates a new Nano ID using the ASCII alphabet.
-func (g *generator) newASCII(length int) (ID, error) {
+// NewInto generates a new Nano ID of the specified length into the caller-owned buffer
+// pointed to by buf, growing it if its capacity is insufficient, and returns an ID that
+// aliases the buffer's memory.
+//
+// Because the returned ID shares memory with *buf, the ID is only valid until the buffer
+// is next written to (including by a subsequent call to NewInto with the same buffer).
+// Callers that need to retain the ID must copy it first, e.g. with strings.Clone.
+//
+// Generators configured with per-ID constraints (such as a leading alphabet) generate the
+// ID with New and copy it into *buf, so they do not benefit from zero-allocation generation.
+//
+// Parameters:
+//   - buf *[]byte: A pointer to the caller-owned buffer that receives the ID.
+//   - length int: The desired number of characters in the generated Nano ID.
+//
+// Returns:
+//   - ID: The generated Nano ID, aliasing *buf.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrNilPointer: Returned if buf is nil.
+//   - ErrInvalidLength: Returned if the provided length is less than or equal to zero.
+//   - ErrLengthTooShort: Returned if the provided length is below the configured minimum length.
+//
+// Usage Example:
+//
+//	buf := make([]byte, 0, 21)
+//	for {
+//	    id, err := generator.NewInto(&buf, 21)
+//	    if err != nil {
+//	        // handle error
+//	    }
+//	    process(id) // id must not be retained after the next call
+//	}
+func (g *generator) NewInto(buf *[]byte, length int) (ID, error) {
+	if buf == nil {
+		return EmptyID, ErrNilPointer
+	}
+
+	if err := g.checkLength(length); err != nil {
+		return EmptyID, err
+	}
+
+	if !g.plain {
+		id, err := g.New(length)
+		if err != nil {
+			return EmptyID, err
+		}
+		*buf = append((*buf)[:0], id...)
+		return ID(unsafe.String(unsafe.SliceData(*buf), len(*buf))), nil
+	}
+
+	if g.config.isASCII {
+		if cap(*buf) < length {
+			*buf = make([]byte, length)
+		}
+		*buf = (*buf)[:length]
+
+		if err := g.fillASCII(g.config.randReader, *buf); err != nil {
+			return EmptyID, err
+		}
+
+		return ID(unsafe.String(unsafe.SliceData(*buf), length)), nil
+	}
+
+	idBufferPtr := g.idPool.Get().(*[]rune)
+	defer g.idPool.Put(idBufferPtr)
+
+	runes := *idBufferPtr
+	if cap(runes) < length {
+		runes = make([]rune, length)
+		*idBufferPtr = runes
+	}
+	runes = runes[:length]
+
+	if err := g.fillUnicode(g.config.randReader, runes); err != nil {
+		return EmptyID, err
+	}
+
+	size := length * g.config.maxBytesPerRune
+	if cap(*buf) < size {
+		*buf = make([]byte, 0, size)
+	}
+	*buf = (*buf)[:0]
+	for _, r := range runes {
+		*buf = utf8.AppendRune(*buf, r)
+	}
+
+	return ID(unsafe.String(unsafe.SliceData(*buf), len(*buf))), nil
+}
+
+// NewMaxBytes generates the longest Nano ID whose UTF-8 encoding is guaranteed to fit
+// within maxBytes, which is useful for fixed-width byte columns with Unicode alphabets.
+//
+// The length is computed as maxBytes / MaxBytesPerRune (after reserving room for any fixed
+// components such as a region code), so every possible ID of that length fits regardless of
+// which characters are drawn. IDs drawn mostly from narrower runes will
+// use fewer than maxBytes bytes.
+//
+// Parameters:
+//   - maxBytes int: The maximum size, in bytes, of the UTF-8 encoded ID.
+//
+// Returns:
+//   - ID: The generated Nano ID.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if maxBytes cannot hold a single character of the alphabet.
+//
+// Usage Example:
+//
+//	id, err := generator.NewMaxBytes(64)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Generated ID:", id)
+func (g *generator) NewMaxBytes(maxBytes int) (ID, error) {
+	length := (maxBytes - g.decorationBytes()) / g.config.maxBytesPerRune
+	if g.config.isLengthPrefixed {
+		length = min(length, int(g.config.alphabetLen)-1)
+	}
+	return g.New(length)
+}
+
+// newASCII generates a new Nano ID using the ASCII alphabet and the given source of randomness.
+func (g *generator) newASCII(reader io.Reader, length int) (ID, error) {
+	// Retrieve the idBuffer from the pool
+	idBufferPtr := g.idPool.Get().(*[]byte)
+	defer func() {
+		g.idPool.Put(idBufferPtr)
+	}()
+
+	idBuffer := *idBufferPtr
+	if cap(idBuffer) < length {
+		idBuffer = make([]byte, length)
+		*idBufferPtr = idBuffer
+	}
+	idBuffer = idBuffer[:length] // Ensure it has the correct length
+
+	if err := g.fillASCII(reader, idBuffer); err != nil {
+		return EmptyID, err
+	}
+
+	return ID(idBuffer), nil
+}
+
+// newUnicode generates a new Nano ID using the Unicode alphabet and the given source of randomness.
+func (g *generator) newUnicode(reader io.Reader, length int) (ID, error) {
+	// Retrieve the idBuffer from the pool
+	idBufferPtr := g.idPool.Get().(*[]rune)
+	defer func() {
+		g.idPool.Put(idBufferPtr)
+	}()
+
+	idBuffer := *idBufferPtr
+	if cap(idBuffer) < length {
+		idBuffer = make([]rune, length)
+		*idBufferPtr = idBuffer
+	}
+	idBuffer = idBuffer[:length] // Ensure it has the correct length
+
+	if err := g.fillUnicode(reader, idBuffer); err != nil {
+		return EmptyID, err
+	}
+
+	return ID(idBuffer), nil
+}
+
+// fillASCII fills idBuffer with characters drawn from the ASCII alphabet using bytes read from reader.
+func (g *generator) fillASCII(reader io.Reader, idBuffer []byte) error {
 	randomBytesPtr := g.entropyPool.Get().(*[]byte)
 	randomBytes := *randomBytesPtr
 	bufferLen := len(randomBytes)
@@ -349,20 +936,13 @@ func (g *generator) newASCII(length int) (ID, error) {
 		g.entropyPool.Put(randomBytesPtr)
 	}()
 
+	length := len(idBuffer)
 	cursor := 0
-	maxAttempts := length * maxAttemptsMultiplier
+	maxAttempts := max(length, minSamplingLength) * g.config.maxAttempts
 	mask := g.config.mask
 	bytesNeeded := g.config.bytesNeeded
 	isPowerOfTwo := g.config.isPowerOfTwo
 
-	// Retrieve the idBuffer from the pool
-	idBufferPtr := g.idPool.Get().(*[]byte)
-	idBuffer := (*idBufferPtr)[:length] // Ensure it has the correct length
-
-	defer func() {
-		g.idPool.Put(idBufferPtr)
-	}()
-
 	for attempts := 0; cursor < length && attempts < maxAttempts; attempts++ {
 		neededBytes := (length - cursor) * int(bytesNeeded)
 		if neededBytes > bufferLen {
@@ -370,8 +950,8 @@ func (g *generator) newASCII(length int) (ID, error) {
 		}
 
 		// Fill the random bytes buffer
-		if _, err := g.config.randReader.Read(randomBytes[:neededBytes]); err != nil {
-			return EmptyID, err
+		if _, err := reader.Read(randomBytes[:neededBytes]); err != nil {
+			return err
 		}
 
 		// Process each segment of random bytes
@@ -388,14 +968,14 @@ func (g *generator) newASCII(length int) (ID, error) {
 
 	// Check for max attempts
 	if cursor < length {
-		return EmptyID, ErrExceededMaxAttempts
+		return ErrExceededMaxAttempts
 	}
 
-	return ID(idBuffer), nil
+	return nil
 }
 
-// newUnicode generates a new Nano ID using the Unicode alphabet.
-func (g *generator) newUnicode(length int) (ID, error) {
+// fillUnicode fills idBuffer with characters drawn from the Unicode alphabet using bytes read from reader.
+func (g *generator) fillUnicode(reader io.Reader, idBuffer []rune) error {
 	// Retrieve random bytes from the pool
 	randomBytesPtr := g.entropyPool.Get().(*[]byte)
 	randomBytes := *randomBytesPtr
@@ -406,20 +986,13 @@ func (g *generator) newUnicode(length int) (ID, error) {
 		g.entropyPool.Put(randomBytesPtr)
 	}()
 
+	length := len(idBuffer)
 	cursor := 0
-	maxAttempts := length * maxAttemptsMultiplier
+	maxAttempts := max(length, minSamplingLength) * g.config.maxAttempts
 	mask := g.config.mask
 	bytesNeeded := g.config.bytesNeeded
 	isPowerOfTwo := g.config.isPowerOfTwo
 
-	// Retrieve the idBuffer from the pool
-	idBufferPtr := g.idPool.Get().(*[]rune)
-	idBuffer := (*idBufferPtr)[:length] // Ensure it has the correct length
-
-	defer func() {
-		g.idPool.Put(idBufferPtr)
-	}()
-
 	for attempts := 0; cursor < length && attempts < maxAttempts; attempts++ {
 		neededBytes := (length - cursor) * int(bytesNeeded)
 		if neededBytes > bufferLen {
@@ -427,8 +1000,8 @@ func (g *generator) newUnicode(length int) (ID, error) {
 		}
 
 		// Fill the random bytes buffer
-		if _, err := g.config.randReader.Read(randomBytes[:neededBytes]); err != nil {
-			return EmptyID, err
+		if _, err := reader.Read(randomBytes[:neededBytes]); err != nil {
+			return err
 		}
 
 		// Process each segment of random bytes
@@ -445,10 +1018,10 @@ func (g *generator) newUnicode(length int) (ID, error) {
 
 	// Check for max attempts
 	if cursor < length {
-		return EmptyID, ErrExceededMaxAttempts
+		return ErrExceededMaxAttempts
 	}
 
-	return ID(idBuffer), nil
+	return nil
 }
 
 // Reader is the interface that wraps the basic Read method.
@@ -497,6 +1070,73 @@ func (g *generator) Read(p []byte) (n int, err error) {
 	return length, nil
 }
 
+// NewIntoBytes generates a new Nano ID of the specified length and writes it into the
+// caller-owned slice dst, returning the number of bytes written.
+//
+// Unlike NewInto, dst is never grown: it must already be long enough to hold the ID. Unlike Read,
+// which fills the whole of its argument, NewIntoBytes writes exactly one ID of the requested
+// length and leaves any remaining bytes of dst untouched. Characters are drawn with the same
+// rejection sampling and attempt budget as New.
+//
+// Only ASCII alphabets are supported, so that each character occupies exactly one byte. Generators
+// configured with per-ID constraints or decorations (such as a leading alphabet or a prefix)
+// generate the ID with New and copy it into dst; the count returned then includes the decorations.
+//
+// Parameters:
+//   - dst []byte: The caller-owned buffer that receives the ID.
+//   - length int: The desired number of characters in the generated Nano ID.
+//
+// Returns:
+//   - int: The number of bytes written to dst.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrInvalidAlphabet: Returned if the generator's alphabet is not ASCII.
+//   - ErrInvalidLength: Returned if the provided length is less than or equal to zero.
+//   - ErrLengthTooShort: Returned if the provided length is below the configured minimum length.
+//   - ErrBufferTooSmall: Returned if dst cannot hold the generated ID.
+//
+// Usage Example:
+//
+//	buf := make([]byte, 21)
+//	for {
+//	    n, err := generator.NewIntoBytes(buf, 21)
+//	    if err != nil {
+//	        // handle error
+//	    }
+//	    conn.Write(buf[:n])
+//	}
+func (g *generator) NewIntoBytes(dst []byte, length int) (int, error) {
+	if !g.config.isASCII {
+		return 0, ErrInvalidAlphabet
+	}
+
+	if err := g.checkLength(length); err != nil {
+		return 0, err
+	}
+
+	if len(dst) < length {
+		return 0, ErrBufferTooSmall
+	}
+
+	if !g.plain {
+		id, err := g.New(length)
+		if err != nil {
+			return 0, err
+		}
+		if len(dst) < len(id) {
+			return 0, ErrBufferTooSmall
+		}
+		return copy(dst, id), nil
+	}
+
+	if err := g.fillASCII(g.config.randReader, dst[:length]); err != nil {
+		return 0, err
+	}
+
+	return length, nil
+}
+
 // processRandomBytes extracts and returns an unsigned integer from the given randomBytes slice,
 // starting at the specified index 'i'. The size of the returned value is determined by the
 // g.config.bytesNeeded field.
@@ -531,3 +1171,32 @@ func (g *generator) processRandomBytes(randomBytes []byte, i int) uint {
 		return rnd
 	}
 }
+
+// randomIndex returns a uniformly distributed integer in the range [0, n) drawn from the
+// configured random reader.
+//
+// It reads 64-bit values and rejects those falling in the final partial interval of size
+// 2^64 mod n, which eliminates modulo bias. The rejection probability is below n/2^64, so
+// the attempt budget is practically never exhausted.
+func (g *generator) randomIndex(n int) (int, error) {
+	if n <= 0 {
+		return 0, ErrInvalidLength
+	}
+
+	var buf [8]byte
+	bound := uint64(n)
+	limit := math.MaxUint64 - (math.MaxUint64%bound+1)%bound
+
+	for attempts := 0; attempts < g.config.maxAttempts; attempts++ {
+		if _, err := g.config.randReader.Read(buf[:]); err != nil {
+			return 0, err
+		}
+
+		v := binary.BigEndian.Uint64(buf[:])
+		if v <= limit {
+			return int(v % bound), nil
+		}
+	}
+
+	return 0, ErrExceededMaxAttempts
+}
@@ -113,6 +113,41 @@ func BenchmarkNanoIDAllocations(b *testing.B) {
 	}
 }
 
+// BenchmarkGenerator_NewBatch compares generating a batch of IDs with separate New calls
+// against a single NewN call, which amortizes reader calls and allocations across the batch.
+func BenchmarkGenerator_NewBatch(b *testing.B) {
+	const (
+		count    = 100
+		idLength = 21
+	)
+
+	gen, err := NewGenerator(WithLengthHint(idLength))
+	if err != nil {
+		b.Fatalf("failed to create generator: %v", err)
+	}
+
+	b.Run("New", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ids := make([]ID, count)
+			for j := range ids {
+				if ids[j], err = gen.New(idLength); err != nil {
+					b.Fatalf("New failed: %v", err)
+				}
+			}
+		}
+	})
+
+	b.Run("NewN", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err = gen.(BatchGenerator).NewN(count, idLength); err != nil {
+				b.Fatalf("NewN failed: %v", err)
+			}
+		}
+	})
+}
+
 // BenchmarkNanoIDAllocationsConcurrent benchmarks the memory allocations and performance of generating
 // a Nano ID concurrently with a length of 21 and an alphabet consisting of uppercase letters,
 // lowercase letters, and numbers.
@@ -157,6 +192,20 @@ func BenchmarkGenerator_Read_DefaultLength(b *testing.B) {
 	}
 }
 
+// BenchmarkGenerator_NewIntoBytes benchmarks writing IDs into a reused caller-owned buffer.
+func BenchmarkGenerator_NewIntoBytes(b *testing.B) {
+	buffer := make([]byte, DefaultLength)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := Generator.(BufferGenerator).NewIntoBytes(buffer, DefaultLength)
+		if err != nil {
+			b.Fatalf("NewIntoBytes returned an unexpected error: %v", err)
+		}
+	}
+}
+
 // BenchmarkGenerator_Read_VaryingBufferSizes benchmarks reading into buffers of varying sizes.
 func BenchmarkGenerator_Read_VaryingBufferSizes(b *testing.B) {
 	bufferSizes := []int{2, 3, 5, 13, 21, 34}
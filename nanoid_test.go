@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 
@@ -1036,3 +1037,305 @@ type errorReader struct{}
 func (e *errorReader) Read(_ []byte) (int, error) {
 	return 0, errors.New("simulated read error")
 }
+
+// TestGenerator_OptionalInterfaces tests that generators implement every optional interface.
+func TestGenerator_OptionalInterfaces(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	is.Implements((*Configuration)(nil), gen)
+	is.Implements((*BufferGenerator)(nil), gen)
+	is.Implements((*MaxBytesGenerator)(nil), gen)
+	is.Implements((*WordGenerator)(nil), gen)
+	is.Implements((*ContentGenerator)(nil), gen)
+	is.Implements((*EntropyGenerator)(nil), gen)
+	is.Implements((*UUIDCodec)(nil), gen)
+	is.Implements((*IntegerCodec)(nil), gen)
+	is.Implements((*DistinctGenerator)(nil), gen)
+	is.Implements((*Validator)(nil), gen)
+	is.Implements((*Inspector)(nil), gen)
+	is.Implements((*StreamGenerator)(nil), gen)
+	is.Implements((*Profiler)(nil), gen)
+	is.Implements((*CrockfordGenerator)(nil), gen)
+	is.Implements((*CommitmentGenerator)(nil), gen)
+	is.Implements((*SequentialGenerator)(nil), gen)
+	is.Implements((*BatchGenerator)(nil), gen)
+	is.Implements((*NamespacedGenerator)(nil), gen)
+	is.Implements((*RingGenerator)(nil), gen)
+	is.Implements((*ContextGenerator)(nil), gen)
+	is.Implements((*UsageGenerator)(nil), gen)
+	is.Implements((*ExtendedConfig)(nil), gen.(Configuration).Config())
+}
+
+// TestGenerator_NewInto tests that NewInto writes a valid ID into the caller-owned buffer.
+func TestGenerator_NewInto(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	var buf []byte
+	id, err := gen.(BufferGenerator).NewInto(&buf, DefaultLength)
+	is.NoError(err, "NewInto should not return an error")
+	is.Equal(DefaultLength, len(id), "Generated ID should have the specified length")
+	is.Equal(string(buf), string(id), "Generated ID should alias the provided buffer")
+	is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+
+	_, err = gen.(BufferGenerator).NewInto(&buf, 0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+
+	_, err = gen.(BufferGenerator).NewInto(nil, DefaultLength)
+	is.Equal(ErrNilPointer, err, "Expected ErrNilPointer")
+}
+
+// TestGenerator_NewIntoUnicode tests that NewInto encodes Unicode IDs into the caller-owned buffer.
+func TestGenerator_NewIntoUnicode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	alphabet := "abc😊🚀🌟"
+	gen, err := NewGenerator(WithAlphabet(alphabet))
+	is.NoError(err, "NewGenerator() should not return an error with a valid custom alphabet")
+
+	var buf []byte
+	id, err := gen.(BufferGenerator).NewInto(&buf, 10)
+	is.NoError(err, "NewInto should not return an error")
+	is.Equal(10, len([]rune(id)), "Generated ID should have the specified length")
+	is.True(isValidID(id, alphabet), "Generated ID contains invalid characters")
+}
+
+// TestGenerator_NewIntoAllocations tests that NewInto does not allocate once the buffer has grown.
+func TestGenerator_NewIntoAllocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	buf := make([]byte, 0, DefaultLength)
+
+	// Warm up the pools.
+	_, err = gen.(BufferGenerator).NewInto(&buf, DefaultLength)
+	is.NoError(err, "NewInto should not return an error")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = gen.(BufferGenerator).NewInto(&buf, DefaultLength)
+	})
+	is.Equal(float64(0), allocs, "NewInto should not allocate after warmup")
+}
+
+// TestGenerator_NewIntoBytes tests that NewIntoBytes writes exactly one ID into the caller-owned slice.
+func TestGenerator_NewIntoBytes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	buf := []byte(strings.Repeat(".", 32))
+	n, err := gen.(BufferGenerator).NewIntoBytes(buf, DefaultLength)
+	is.NoError(err, "NewIntoBytes should not return an error")
+	is.Equal(DefaultLength, n, "NewIntoBytes should report the number of bytes written")
+	is.True(isValidID(ID(buf[:n]), DefaultAlphabet), "Generated ID contains invalid characters")
+	is.Equal(strings.Repeat(".", 32-DefaultLength), string(buf[n:]), "Bytes beyond the ID should be untouched")
+
+	_, err = gen.(BufferGenerator).NewIntoBytes(buf, 0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+
+	_, err = gen.(BufferGenerator).NewIntoBytes(buf[:DefaultLength-1], DefaultLength)
+	is.Equal(ErrBufferTooSmall, err, "Expected ErrBufferTooSmall")
+
+	unicodeGen, err := NewGenerator(WithAlphabet("abc😊🚀🌟"))
+	is.NoError(err, "NewGenerator() should not return an error with a valid custom alphabet")
+	_, err = unicodeGen.(BufferGenerator).NewIntoBytes(buf, 10)
+	is.Equal(ErrInvalidAlphabet, err, "Expected ErrInvalidAlphabet for a Unicode alphabet")
+}
+
+// TestGenerator_NewIntoBytesDecorated tests that NewIntoBytes includes decorations in the count.
+func TestGenerator_NewIntoBytesDecorated(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithPrefix("usr_"))
+	is.NoError(err, "NewGenerator() should not return an error with a prefix")
+
+	buf := make([]byte, 32)
+	n, err := gen.(BufferGenerator).NewIntoBytes(buf, 10)
+	is.NoError(err, "NewIntoBytes should not return an error")
+	is.Equal(14, n, "The count should include the prefix")
+	is.True(strings.HasPrefix(string(buf[:n]), "usr_"), "The ID should carry the prefix")
+
+	_, err = gen.(BufferGenerator).NewIntoBytes(buf[:12], 10)
+	is.Equal(ErrBufferTooSmall, err, "Expected ErrBufferTooSmall when the decorations do not fit")
+}
+
+// TestGenerator_NewIntoBytesAllocations tests that NewIntoBytes does not allocate.
+func TestGenerator_NewIntoBytesAllocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	buf := make([]byte, DefaultLength)
+
+	// Warm up the pools.
+	_, err = gen.(BufferGenerator).NewIntoBytes(buf, DefaultLength)
+	is.NoError(err, "NewIntoBytes should not return an error")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = gen.(BufferGenerator).NewIntoBytes(buf, DefaultLength)
+	})
+	is.Equal(float64(0), allocs, "NewIntoBytes should not allocate after warmup")
+}
+
+// TestGenerator_NewMaxBytes tests that NewMaxBytes produces IDs fitting within the byte budget.
+func TestGenerator_NewMaxBytes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Every rune in this alphabet requires 4 bytes in UTF-8.
+	alphabet := "😊🚀🌟🎉"
+	gen, err := NewGenerator(WithAlphabet(alphabet))
+	is.NoError(err, "NewGenerator() should not return an error with a valid custom alphabet")
+
+	for _, maxBytes := range []int{4, 7, 64, 65} {
+		id, err := gen.(MaxBytesGenerator).NewMaxBytes(maxBytes)
+		is.NoError(err, "NewMaxBytes(%d) should not return an error", maxBytes)
+		is.LessOrEqual(len(id), maxBytes, "Generated ID should fit within maxBytes")
+		is.Equal(maxBytes/4, len([]rune(id)), "Generated ID should be the longest that fits")
+		is.True(isValidID(id, alphabet), "Generated ID contains invalid characters")
+	}
+
+	_, err = gen.(MaxBytesGenerator).NewMaxBytes(3)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength when no character fits")
+
+	// ASCII alphabets use one byte per character.
+	id, err := Generator.(MaxBytesGenerator).NewMaxBytes(32)
+	is.NoError(err, "NewMaxBytes should not return an error")
+	is.Equal(32, len(id), "ASCII IDs should use the full byte budget")
+}
+
+// TestGenerator_WithPrefixAndSuffix tests that the prefix and suffix wrap every ID without
+// counting toward the requested length.
+func TestGenerator_WithPrefixAndSuffix(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithPrefix("usr_"), WithSuffix(".µ"))
+	is.NoError(err, "NewGenerator() should not return an error with a prefix and suffix")
+
+	config := gen.(Configuration).Config()
+	is.Equal("usr_", config.Prefix(), "Config should expose the prefix")
+	is.Equal(".µ", config.Suffix(), "Config should expose the suffix")
+
+	id, err := gen.New(10)
+	is.NoError(err, "New should not return an error")
+	is.True(strings.HasPrefix(string(id), "usr_"), "ID should start with the prefix")
+	is.True(strings.HasSuffix(string(id), ".µ"), "ID should end with the suffix")
+
+	body := strings.TrimSuffix(strings.TrimPrefix(string(id), "usr_"), ".µ")
+	is.Len(body, 10, "The random portion should have the requested length")
+	is.True(isValidID(ID(body), DefaultAlphabet), "The random portion contains invalid characters")
+
+	is.NoError(gen.(Validator).ValidateStrict(id, 10), "ValidateStrict should accept a generated ID")
+	is.Equal(ErrInvalidAffix, gen.(Validator).ValidateStrict(ID(body), 10), "Expected ErrInvalidAffix without the prefix and suffix")
+
+	parsed, err := gen.(Validator).Parse(string(id))
+	is.NoError(err, "Parse should accept a generated ID")
+	is.Equal(id, parsed)
+
+	_, err = gen.(Validator).Parse("usr_abc!.µ")
+	is.ErrorIs(err, ErrInvalidID, "Parse should reject characters outside the alphabet")
+	is.Contains(err.Error(), "position 7", "Parse should report positions relative to the whole string")
+
+	_, err = gen.(Validator).Parse("ord_abcdef.µ")
+	is.ErrorIs(err, ErrInvalidID, "Parse should reject a different prefix")
+
+	ids, err := gen.(BatchGenerator).NewN(5, 8)
+	is.NoError(err, "NewN should not return an error")
+	for _, id := range ids {
+		is.NoError(gen.(Validator).ValidateStrict(id, 8), "NewN should decorate every ID")
+	}
+}
+
+// TestGenerator_WithPrefixAndRegionCode tests that the prefix precedes the region code
+// and does not interfere with recovering it.
+func TestGenerator_WithPrefixAndRegionCode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithPrefix("ord_"), WithRegionCode("eu"), WithLengthPrefix(true))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id, err := gen.New(12)
+	is.NoError(err, "New should not return an error")
+	is.True(strings.HasPrefix(string(id), "ord_eu"), "The prefix should precede the region code")
+
+	region, err := gen.(Inspector).RegionOf(id)
+	is.NoError(err, "RegionOf should not return an error")
+	is.Equal("eu", region)
+
+	n, err := gen.(Inspector).LengthOf(id)
+	is.NoError(err, "LengthOf should not return an error")
+	is.Equal(12, n)
+
+	_, err = gen.(Inspector).RegionOf(id[len("ord_"):])
+	is.Equal(ErrInvalidAffix, err, "Expected ErrInvalidAffix without the prefix")
+}
+
+// TestWithPrefixInvalid tests that prefixes and suffixes must be valid UTF-8.
+func TestWithPrefixInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := NewGenerator(WithPrefix("\xff"))
+	is.Equal(ErrInvalidAffix, err, "Expected ErrInvalidAffix for a non-UTF-8 prefix")
+
+	_, err = NewGenerator(WithSuffix("\xfe"))
+	is.Equal(ErrInvalidAffix, err, "Expected ErrInvalidAffix for a non-UTF-8 suffix")
+}
+
+// TestPresetAlphabets tests that every preset alphabet is accepted by NewGenerator and has the expected length.
+func TestPresetAlphabets(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		name     string
+		alphabet string
+		length   int
+	}{
+		{"Base58", AlphabetBase58, 58},
+		{"Base62", AlphabetBase62, 62},
+		{"Base32", AlphabetBase32, 32},
+		{"Base32Hex", AlphabetBase32Hex, 32},
+		{"CrockfordBase32", AlphabetCrockfordBase32, 32},
+		{"HexLower", AlphabetHexLower, 16},
+		{"HexUpper", AlphabetHexUpper, 16},
+	}
+
+	for _, tt := range tests {
+		gen, err := NewGenerator(WithAlphabet(tt.alphabet))
+		is.NoError(err, "NewGenerator() should accept the %s alphabet", tt.name)
+		is.Equal(tt.length, len(tt.alphabet), "The %s alphabet should have %d characters", tt.name, tt.length)
+		is.Equal(uint16(tt.length), gen.(Configuration).Config().AlphabetLen(), "The %s alphabet should have no duplicates", tt.name)
+
+		id, err := gen.New(DefaultLength)
+		is.NoError(err, "New should not return an error with the %s alphabet", tt.name)
+		is.True(isValidID(id, tt.alphabet), "Generated ID contains characters outside the %s alphabet", tt.name)
+	}
+
+	is.NotContains(AlphabetBase58, "0", "Base58 should omit 0")
+	is.NotContains(AlphabetBase58, "O", "Base58 should omit O")
+	is.NotContains(AlphabetBase58, "I", "Base58 should omit I")
+	is.NotContains(AlphabetBase58, "l", "Base58 should omit l")
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Parse converts a raw string into an ID, validating it against DefaultAlphabet.
+//
+// It is shorthand for Generator.Parse(s). See the generator method for the checks performed.
+// If Generator has been replaced by an implementation that is not a Validator, Parse returns
+// an error wrapping ErrUnsupportedType.
+//
+// Usage:
+//
+//	id, err := nanoid.Parse(r.URL.Query().Get("id"))
+//	if errors.Is(err, nanoid.ErrInvalidID) {
+//	    // reject the request
+//	}
+func Parse(s string) (ID, error) {
+	v, ok := Generator.(Validator)
+	if !ok {
+		return EmptyID, fmt.Errorf("%w: %T does not implement Validator", ErrUnsupportedType, Generator)
+	}
+
+	return v.Parse(s)
+}
+
+// Parse converts a raw string into an ID if it is well-formed for this generator.
+//
+// The string must be valid UTF-8 and carry the configured prefix, version character and suffix,
+// if any. Between them, it must contain at least the generator's minimum length in characters and
+// consist solely of characters from the generator's alphabet. When a leading alphabet is
+// configured, the first random character (after any region code and length prefix) is checked
+// against it instead. Errors wrap ErrInvalidID and describe the offending character and its
+// zero-based character position, so they can be tested with errors.Is.
+//
+// Parameters:
+//   - s string: The raw string to parse.
+//
+// Returns:
+//   - ID: The parsed ID.
+//   - error: An error wrapping ErrInvalidID if the string is not a well-formed ID.
+//
+// Usage Example:
+//
+//	id, err := generator.Parse(input)
+//	if err != nil {
+//	    // err might read "invalid ID: character '!' at position 4 not in alphabet"
+//	}
+func (g *generator) Parse(s string) (ID, error) {
+	if !utf8.ValidString(s) {
+		return EmptyID, fmt.Errorf("%w: not valid UTF-8", ErrInvalidID)
+	}
+
+	body, err := g.trimFixed(s)
+	if err != nil {
+		return EmptyID, fmt.Errorf("%w: %w", ErrInvalidID, err)
+	}
+
+	if n := utf8.RuneCountInString(body); n < g.config.minLength {
+		return EmptyID, fmt.Errorf("%w: length %d below minimum %d", ErrInvalidID, n, g.config.minLength)
+	}
+
+	leadingPos := -1
+	if g.leading != nil {
+		leadingPos = utf8.RuneCountInString(g.config.regionCode)
+		if g.config.isLengthPrefixed {
+			leadingPos++
+		}
+		leadingPos += g.config.timePrefixWidth
+	}
+
+	// Positions are reported relative to the whole string, including the prefix and version.
+	offset := utf8.RuneCountInString(g.config.prefix)
+	if g.config.isVersioned {
+		offset++
+	}
+	pos := 0
+	for _, r := range body {
+		index := g.config.runeIndex
+		if pos == leadingPos {
+			index = g.leading.config.runeIndex
+		}
+
+		if _, ok := index[r]; !ok {
+			return EmptyID, fmt.Errorf("%w: character %q at position %d not in alphabet", ErrInvalidID, r, offset+pos)
+		}
+		pos++
+	}
+
+	return ID(s), nil
+}
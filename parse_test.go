@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParse tests parsing strings against the default alphabet.
+func TestParse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	expected := Must()
+	id, err := Parse(string(expected))
+	is.NoError(err, "Parse should accept a generated ID")
+	is.Equal(expected, id)
+
+	tests := []struct {
+		input   string
+		message string
+	}{
+		{"", "invalid ID: length 0 below minimum 1"},
+		{"abc!def", "invalid ID: character '!' at position 3 not in alphabet"},
+		{"αβγ", "invalid ID: character 'α' at position 0 not in alphabet"},
+		{string([]byte{'a', 0xff}), "invalid ID: not valid UTF-8"},
+	}
+
+	for _, tt := range tests {
+		id, err := Parse(tt.input)
+		is.ErrorIs(err, ErrInvalidID, "Parse(%q) should return ErrInvalidID", tt.input)
+		is.EqualError(err, tt.message)
+		is.Equal(EmptyID, id)
+	}
+}
+
+// TestGenerator_Parse tests parsing strings against a generator's configuration.
+func TestGenerator_Parse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(
+		WithAlphabet("0123456789"),
+		WithLeadingAlphabet("xyz"),
+		WithMinLength(4),
+	)
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id, err := gen.New(8)
+	is.NoError(err, "New should not return an error")
+
+	parsed, err := gen.(Validator).Parse(string(id))
+	is.NoError(err, "Parse should accept a generated ID")
+	is.Equal(id, parsed)
+
+	_, err = gen.(Validator).Parse("x12")
+	is.ErrorIs(err, ErrInvalidID)
+	is.EqualError(err, "invalid ID: length 3 below minimum 4")
+
+	_, err = gen.(Validator).Parse("1234")
+	is.ErrorIs(err, ErrInvalidID)
+	is.EqualError(err, "invalid ID: character '1' at position 0 not in alphabet")
+
+	_, err = gen.(Validator).Parse("x12a")
+	is.ErrorIs(err, ErrInvalidID)
+	is.EqualError(err, "invalid ID: character 'a' at position 3 not in alphabet")
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"go/token"
+	"math"
+	"strings"
+)
+
+const (
+	// goIdentifierLeadingAlphabet is the set of characters permitted as the first character
+	// of a generated Go identifier.
+	goIdentifierLeadingAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz_"
+
+	// goIdentifierAlphabet is the set of characters permitted after the first character
+	// of a generated Go identifier.
+	goIdentifierAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_"
+)
+
+const (
+	// filesystemSafeAlphabet is the set of characters used by NewFilesystemSafeGenerator. It is
+	// lowercase-only so that IDs stay distinct on case-insensitive filesystems.
+	filesystemSafeAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz_-"
+
+	// filesystemSafeLeadingAlphabet excludes '-' so that IDs are never mistaken for command-line flags.
+	filesystemSafeLeadingAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz_"
+
+	// filesystemForbidden lists the printable characters that are illegal in file names on Windows,
+	// macOS or Linux. Control characters are also forbidden.
+	filesystemForbidden = `<>:"/\|?*`
+)
+
+const (
+	// dnsLabelAlphabet is the set of characters used by NewDNSLabelGenerator. Hyphens are
+	// omitted so that labels can never start or end with one.
+	dnsLabelAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	// dnsLabelMaxLength is the longest permitted DNS label (RFC 1035).
+	dnsLabelMaxLength = 63
+)
+
+// windowsReservedNames are device names that Windows refuses as file names, regardless of case.
+var windowsReservedNames = map[string]struct{}{
+	"con": {}, "prn": {}, "aux": {}, "nul": {},
+	"com1": {}, "com2": {}, "com3": {}, "com4": {}, "com5": {}, "com6": {}, "com7": {}, "com8": {}, "com9": {},
+	"lpt1": {}, "lpt2": {}, "lpt3": {}, "lpt4": {}, "lpt5": {}, "lpt6": {}, "lpt7": {}, "lpt8": {}, "lpt9": {},
+}
+
+// AlphabetQRAlphanumeric is the 45-character set of the QR code alphanumeric mode (ISO/IEC 18004),
+// in the order of its character values 0 through 44. IDs drawn from it are encoded at 5.5 bits per
+// character in a QR code, rather than the 8 bits per character of byte mode.
+const AlphabetQRAlphanumeric = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// NewGoIdentifierGenerator creates a generator whose IDs are always valid Go identifiers,
+// suitable for code generation.
+//
+// The first character is drawn from [A-Za-z_] and the remaining characters from [A-Za-z0-9_].
+// Candidates that collide with a Go keyword (e.g. "if" or "func") are regenerated.
+//
+// Parameters:
+//   - length int: The intended length of the generated identifiers, used as the length hint.
+//
+// Returns:
+//   - Interface: A generator producing valid Go identifiers.
+//   - error: An error object if the generator could not be created.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if length is less than 1 or greater than math.MaxUint16.
+//
+// Usage:
+//
+//	generator, err := nanoid.NewGoIdentifierGenerator(12)
+//	if err != nil {
+//	    // handle error
+//	}
+//	id, err := generator.New(12)
+func NewGoIdentifierGenerator(length int) (Interface, error) {
+	if length < 1 || length > math.MaxUint16 {
+		return nil, ErrInvalidLength
+	}
+
+	return NewGenerator(
+		WithAlphabet(goIdentifierAlphabet),
+		WithLeadingAlphabet(goIdentifierLeadingAlphabet),
+		WithLengthHint(uint16(length)),
+		withFilter(func(id ID) bool {
+			return !token.IsKeyword(string(id))
+		}),
+	)
+}
+
+// NewQRGenerator creates a generator whose IDs use only the QR code alphanumeric character set,
+// so they embed compactly in QR codes.
+//
+// Note that the set includes the space character and several punctuation marks, which may
+// need escaping in URLs or shell contexts.
+//
+// Parameters:
+//   - length int: The intended length of the generated IDs, used as the length hint.
+//
+// Returns:
+//   - Interface: A generator producing QR-alphanumeric IDs.
+//   - error: An error object if the generator could not be created.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if length is less than 1 or greater than math.MaxUint16.
+//
+// Usage:
+//
+//	generator, err := nanoid.NewQRGenerator(16)
+//	if err != nil {
+//	    // handle error
+//	}
+//	id, err := generator.New(16)
+func NewQRGenerator(length int) (Interface, error) {
+	if length < 1 || length > math.MaxUint16 {
+		return nil, ErrInvalidLength
+	}
+
+	return NewGenerator(
+		WithAlphabet(AlphabetQRAlphanumeric),
+		WithLengthHint(uint16(length)),
+	)
+}
+
+// NewFilesystemSafeGenerator creates a generator whose IDs can be used as file names on Windows,
+// macOS and Linux.
+//
+// IDs are drawn from lowercase letters, digits, '_' and '-', so they contain none of the characters
+// forbidden by any of those systems and remain distinct on case-insensitive filesystems. The first
+// character is never '-', and candidates matching a reserved Windows device name (e.g. "CON" or
+// "LPT1") are regenerated.
+//
+// Parameters:
+//   - length int: The intended length of the generated IDs, used as the length hint.
+//
+// Returns:
+//   - Interface: A generator producing filesystem-safe IDs.
+//   - error: An error object if the generator could not be created.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if length is less than 1 or greater than math.MaxUint16.
+//
+// Usage:
+//
+//	generator, err := nanoid.NewFilesystemSafeGenerator(16)
+//	if err != nil {
+//	    // handle error
+//	}
+//	id, err := generator.New(16)
+//	f, err := os.Create(filepath.Join(dir, string(id)))
+func NewFilesystemSafeGenerator(length int) (Interface, error) {
+	if length < 1 || length > math.MaxUint16 {
+		return nil, ErrInvalidLength
+	}
+
+	return NewGenerator(
+		WithAlphabet(filesystemSafeAlphabet),
+		WithLeadingAlphabet(filesystemSafeLeadingAlphabet),
+		WithLengthHint(uint16(length)),
+		withFilter(func(id ID) bool {
+			return !isReservedFilename(string(id))
+		}),
+	)
+}
+
+// isReservedFilename reports whether name is a reserved Windows device name, ignoring case.
+func isReservedFilename(name string) bool {
+	_, ok := windowsReservedNames[strings.ToLower(name)]
+	return ok
+}
+
+// isFilesystemSafeRune reports whether r may appear in a file name on Windows, macOS and Linux.
+func isFilesystemSafeRune(r rune) bool {
+	return r >= 0x20 && r != 0x7f && !strings.ContainsRune(filesystemForbidden, r)
+}
+
+// NewDNSLabelGenerator creates a generator whose IDs are valid DNS labels, suitable for minting
+// per-tenant subdomains.
+//
+// IDs are drawn from lowercase letters and digits only, so they never start or end with a hyphen
+// and are unaffected by DNS case-insensitivity. Requests for IDs longer than 63 characters, the
+// DNS label limit, fail with ErrInvalidLength.
+//
+// Parameters:
+//   - length int: The intended length of the generated labels, used as the length hint.
+//
+// Returns:
+//   - Interface: A generator producing DNS labels.
+//   - error: An error object if the generator could not be created.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if length is less than 1 or greater than 63.
+//
+// Usage:
+//
+//	generator, err := nanoid.NewDNSLabelGenerator(12)
+//	if err != nil {
+//	    // handle error
+//	}
+//	label, err := generator.New(12)
+//	host := string(label) + ".example.com"
+func NewDNSLabelGenerator(length int) (Interface, error) {
+	if length < 1 || length > dnsLabelMaxLength {
+		return nil, ErrInvalidLength
+	}
+
+	return NewGenerator(
+		WithAlphabet(dnsLabelAlphabet),
+		WithLengthHint(uint16(length)),
+		withMaxLength(dnsLabelMaxLength),
+	)
+}
+
+// isDNSLabelRune reports whether r may appear anywhere in a DNS label.
+func isDNSLabelRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9')
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewGoIdentifierGenerator tests that every generated ID is a valid Go identifier.
+func TestNewGoIdentifierGenerator(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGoIdentifierGenerator(8)
+	is.NoError(err, "NewGoIdentifierGenerator() should not return an error")
+
+	for _, length := range []int{1, 2, 8, 32} {
+		for i := 0; i < 1000; i++ {
+			id, err := gen.New(length)
+			is.NoError(err, "New should not return an error")
+			is.Equal(length, len(id), "Generated ID should have the specified length")
+			is.True(token.IsIdentifier(string(id)), "Generated ID %q should be a valid Go identifier", id)
+		}
+	}
+
+	_, err = NewGoIdentifierGenerator(0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+}
+
+// TestWithLeadingAlphabet tests that the first character is drawn from the leading alphabet.
+func TestWithLeadingAlphabet(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(
+		WithAlphabet("0123456789"),
+		WithLeadingAlphabet("xyz"),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a leading alphabet")
+
+	for i := 0; i < 100; i++ {
+		id, err := gen.New(10)
+		is.NoError(err, "New should not return an error")
+		is.Equal(10, len(id), "Generated ID should have the specified length")
+		is.True(isValidID(id[:1], "xyz"), "First character should come from the leading alphabet")
+		is.True(isValidID(id[1:], "0123456789"), "Remaining characters should come from the alphabet")
+	}
+
+	var buf []byte
+	id, err := gen.(BufferGenerator).NewInto(&buf, 10)
+	is.NoError(err, "NewInto should not return an error")
+	is.True(isValidID(id[:1], "xyz"), "NewInto should honor the leading alphabet")
+
+	_, err = NewGenerator(WithLeadingAlphabet("aa"))
+	is.Equal(ErrDuplicateCharacters, err, "Expected ErrDuplicateCharacters for an invalid leading alphabet")
+}
+
+// TestAlphabetQRAlphanumeric tests that the QR alphabet matches the ISO/IEC 18004 alphanumeric table.
+func TestAlphabetQRAlphanumeric(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Character values 0-44 of the QR alphanumeric mode.
+	expected := []byte{
+		'0', '1', '2', '3', '4', '5', '6', '7', '8', '9',
+		'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M',
+		'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z',
+		' ', '$', '%', '*', '+', '-', '.', '/', ':',
+	}
+	is.Len(expected, 45)
+	is.Equal(string(expected), AlphabetQRAlphanumeric, "AlphabetQRAlphanumeric should match the QR specification")
+}
+
+// TestNewQRGenerator tests that generated IDs contain only QR alphanumeric characters.
+func TestNewQRGenerator(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewQRGenerator(16)
+	is.NoError(err, "NewQRGenerator() should not return an error")
+
+	for i := 0; i < 1000; i++ {
+		id, err := gen.New(16)
+		is.NoError(err, "New should not return an error")
+		is.Len(id, 16, "Generated ID should have the specified length")
+		is.True(isValidID(id, AlphabetQRAlphanumeric), "Generated ID %q contains non-QR characters", id)
+	}
+
+	_, err = NewQRGenerator(0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+}
+
+// TestNewFilesystemSafeGenerator tests that generated IDs are usable as file names everywhere.
+func TestNewFilesystemSafeGenerator(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewFilesystemSafeGenerator(3)
+	is.NoError(err, "NewFilesystemSafeGenerator() should not return an error")
+	is.True(gen.(Configuration).Config().IsFilesystemSafe(), "Config.IsFilesystemSafe should be true")
+
+	// Short IDs make reserved names such as "con" and "nul" likely to come up as candidates.
+	for i := 0; i < 10000; i++ {
+		id, err := gen.New(3)
+		is.NoError(err, "New should not return an error")
+		is.False(strings.ContainsAny(string(id), filesystemForbidden), "ID %q contains a forbidden character", id)
+		is.False(isReservedFilename(string(id)), "ID %q is a reserved file name", id)
+		is.NotEqual(byte('-'), id[0], "ID %q should not start with '-'", id)
+		is.Equal(strings.ToLower(string(id)), string(id), "ID %q should be lowercase", id)
+	}
+
+	_, err = NewFilesystemSafeGenerator(0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+}
+
+// TestIsReservedFilename tests detection of reserved Windows device names.
+func TestIsReservedFilename(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, name := range []string{"CON", "con", "Prn", "aux", "NUL", "com1", "LPT9"} {
+		is.True(isReservedFilename(name), "%q should be reserved", name)
+	}
+	for _, name := range []string{"cons", "com0", "lpt", "abc", ""} {
+		is.False(isReservedFilename(name), "%q should not be reserved", name)
+	}
+}
+
+// TestConfig_IsFilesystemSafe tests the filesystem safety of various alphabets.
+func TestConfig_IsFilesystemSafe(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		alphabet string
+		expected bool
+	}{
+		{DefaultAlphabet, true},
+		{"abc.", true},
+		{"abc:", false},
+		{"ab/c", false},
+		{`ab\c`, false},
+		{"ab*?", false},
+		{"ab\tc", false},
+	}
+
+	for _, tt := range tests {
+		gen, err := NewGenerator(WithAlphabet(tt.alphabet))
+		is.NoError(err, "NewGenerator(%q) should not return an error", tt.alphabet)
+		is.Equal(tt.expected, gen.(Configuration).Config().IsFilesystemSafe(), "IsFilesystemSafe for %q", tt.alphabet)
+	}
+}
+
+// TestNewDNSLabelGeneratorSingleCharacter tests that one-character labels do not exhaust the
+// sampling budget, which a 36-character alphabet would otherwise do about once in 4000 calls.
+func TestNewDNSLabelGeneratorSingleCharacter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewDNSLabelGenerator(1)
+	is.NoError(err, "NewDNSLabelGenerator() should not return an error")
+
+	for i := 0; i < 20000; i++ {
+		if _, err := gen.New(1); err != nil {
+			is.NoError(err, "New(1) should not return an error")
+			return
+		}
+	}
+}
+
+// TestNewDNSLabelGenerator tests that generated IDs are valid DNS labels.
+func TestNewDNSLabelGenerator(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewDNSLabelGenerator(12)
+	is.NoError(err, "NewDNSLabelGenerator() should not return an error")
+	is.True(gen.(Configuration).Config().IsDNSLabelSafe(), "Config.IsDNSLabelSafe should be true")
+
+	for _, length := range []int{1, 12, 63} {
+		for i := 0; i < 1000; i++ {
+			id, err := gen.New(length)
+			is.NoError(err, "New(%d) should not return an error", length)
+			is.Len(id, length, "Generated label should have the requested length")
+			is.True(isValidID(id, dnsLabelAlphabet), "Label %q contains invalid characters", id)
+			is.False(strings.HasPrefix(string(id), "-") || strings.HasSuffix(string(id), "-"),
+				"Label %q should not start or end with a hyphen", id)
+		}
+	}
+
+	_, err = gen.New(64)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength beyond the DNS label limit")
+
+	for _, length := range []int{0, 64} {
+		_, err = NewDNSLabelGenerator(length)
+		is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for length %d", length)
+	}
+}
+
+// TestConfig_IsDNSLabelSafe tests the DNS label safety of various alphabets.
+func TestConfig_IsDNSLabelSafe(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		alphabet string
+		expected bool
+	}{
+		{"abc123", true},
+		{DefaultAlphabet, false},
+		{"abc-", false},
+		{"abcD", false},
+		{"abc.", false},
+	}
+
+	for _, tt := range tests {
+		gen, err := NewGenerator(WithAlphabet(tt.alphabet))
+		is.NoError(err, "NewGenerator(%q) should not return an error", tt.alphabet)
+		is.Equal(tt.expected, gen.(Configuration).Config().IsDNSLabelSafe(), "IsDNSLabelSafe for %q", tt.alphabet)
+	}
+}
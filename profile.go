@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"runtime"
+	"time"
+)
+
+// Profiler is implemented by generators that can report their own performance and capacity.
+type Profiler interface {
+	// Benchmark generates 'iterations' IDs of the specified length and reports the approximate
+	// mean time and heap allocations per ID, to help profile custom alphabets. The allocation
+	// figure counts allocations made by every goroutine in the process during the run.
+	//
+	// Usage:
+	//   ns, allocs := generator.Benchmark(100000, 21)
+	//   fmt.Printf("%.1f ns/op, %.1f allocs/op\n", ns, allocs)
+	Benchmark(iterations, length int) (nsPerOp float64, allocsPerOp float64)
+
+	// SafeCapacity returns approximately how many IDs of the specified length can be generated
+	// with this generator's alphabet before the collision probability reaches the given target.
+	//
+	// Usage:
+	//   n := generator.SafeCapacity(21, 1e-9)
+	//   fmt.Printf("Up to %d IDs before a one-in-a-billion collision risk\n", n)
+	SafeCapacity(length int, collisionProbability float64) uint64
+}
+
+// Benchmark measures the approximate cost of generating IDs of the specified length with this
+// generator, which helps when tuning a custom alphabet or length.
+//
+// It runs New 'iterations' times in a tight loop and reports the mean wall-clock time and the
+// mean number of heap allocations per ID. Allocations are counted from the difference in
+// runtime.MemStats.Mallocs before and after the loop, as testing.AllocsPerRun does, so that this
+// package need not import the testing package.
+//
+// The figures are approximate. Mallocs is process-wide, so allocations made concurrently by any
+// other goroutine are attributed to the generator; the allocation figure is only meaningful when
+// the process is otherwise idle. Timings include timer overhead, are affected by other goroutines
+// and the garbage collector, and vary between runs. Each call also invokes runtime.ReadMemStats
+// twice, which briefly stops the world, so Benchmark should not be called on a hot path of a
+// production service. Use `go test -bench` for rigorous results.
+//
+// Parameters:
+//   - iterations int: The number of IDs to generate. Non-positive values return zeros.
+//   - length int: The desired number of characters in each generated Nano ID. Lengths that New
+//     would reject, such as zero or a length below the configured minimum, return zeros.
+//
+// Returns:
+//   - nsPerOp float64: The mean time in nanoseconds to generate one ID.
+//   - allocsPerOp float64: The mean number of heap allocations per generated ID, counted
+//     process-wide.
+//
+// Usage Example:
+//
+//	ns, allocs := generator.Benchmark(100000, 21)
+//	fmt.Printf("%.1f ns/op, %.1f allocs/op\n", ns, allocs)
+func (g *generator) Benchmark(iterations, length int) (nsPerOp float64, allocsPerOp float64) {
+	if iterations <= 0 || g.checkLength(length) != nil {
+		return 0, 0
+	}
+
+	// Warm up the buffer pools so their initial allocations are not counted.
+	_, _ = g.New(length)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	for i := 0; i < iterations; i++ {
+		_, _ = g.New(length)
+	}
+
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	nsPerOp = float64(elapsed.Nanoseconds()) / float64(iterations)
+	allocsPerOp = float64(after.Mallocs-before.Mallocs) / float64(iterations)
+
+	return nsPerOp, allocsPerOp
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_Benchmark tests that Benchmark reports sane values for the default alphabet.
+func TestGenerator_Benchmark(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	ns, allocs := gen.(Profiler).Benchmark(1000, DefaultLength)
+	is.Positive(ns, "Benchmark should report a positive time per operation")
+	is.Less(ns, float64(1e7), "Benchmark should report a plausible time per operation")
+	is.GreaterOrEqual(allocs, float64(0), "Benchmark should report a non-negative allocation count")
+
+	ns, allocs = gen.(Profiler).Benchmark(0, DefaultLength)
+	is.Zero(ns, "Benchmark should report zero time for no iterations")
+	is.Zero(allocs, "Benchmark should report zero allocations for no iterations")
+
+	ns, allocs = gen.(Profiler).Benchmark(1000, 0)
+	is.Zero(ns, "Benchmark should report zero time for an invalid length")
+	is.Zero(allocs, "Benchmark should report zero allocations for an invalid length")
+
+	gen, err = NewGenerator(WithMinLength(10))
+	is.NoError(err, "NewGenerator() should not return an error with a minimum length")
+
+	ns, allocs = gen.(Profiler).Benchmark(1000, 5)
+	is.Zero(ns, "Benchmark should report zero time for a length below the minimum")
+	is.Zero(allocs, "Benchmark should report zero allocations for a length below the minimum")
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+//go:build race
+
+package nanoid
+
+// raceEnabled reports whether the race detector is on; its instrumentation allocates.
+const raceEnabled = true
@@ -0,0 +1,47 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"slices"
+	"sync"
+)
+
+// recentBuffer is a fixed-size, concurrency-safe ring of the most recently issued IDs.
+type recentBuffer struct {
+	mu   sync.Mutex
+	ids  []ID
+	next int
+}
+
+// newRecentBuffer creates a ring holding the last size IDs.
+func newRecentBuffer(size int) *recentBuffer {
+	return &recentBuffer{
+		ids: make([]ID, 0, size),
+	}
+}
+
+// contains reports whether id is in the ring.
+func (r *recentBuffer) contains(id ID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Contains(r.ids, id)
+}
+
+// add records id, evicting the oldest entry when the ring is full.
+func (r *recentBuffer) add(id ID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.ids) < cap(r.ids) {
+		r.ids = append(r.ids, id)
+		return
+	}
+
+	r.ids[r.next] = id
+	r.next = (r.next + 1) % len(r.ids)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithRecentBuffer tests that a candidate matching a recently issued ID is regenerated.
+func TestWithRecentBuffer(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// The reader yields AB, AB, CD in turn, so without the ring the second ID would repeat the first.
+	data := []byte{0, 1, 0, 1, 2, 3}
+
+	plain, err := NewGenerator(WithAlphabet("ABCD"), WithRandReader(&cyclicReader{data: data}))
+	is.NoError(err, "NewGenerator() should not return an error")
+	for _, want := range []ID{"AB", "AB", "CD"} {
+		id, err := plain.New(2)
+		is.NoError(err, "New() should not return an error")
+		is.Equal(want, id)
+	}
+
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&cyclicReader{data: data}),
+		WithRecentBuffer(1),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a recent buffer")
+	for _, want := range []ID{"AB", "CD", "AB", "CD"} {
+		id, err := gen.New(2)
+		is.NoError(err, "New() should not return an error")
+		is.Equal(want, id, "A candidate matching the previous ID should be regenerated")
+	}
+}
+
+// TestWithRecentBufferExhausted tests that a constant reader exhausts the retry budget.
+func TestWithRecentBufferExhausted(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&cyclicReader{data: []byte{0}}),
+		WithRecentBuffer(4),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a recent buffer")
+
+	id, err := gen.New(8)
+	is.NoError(err, "The first ID should be issued")
+	is.Equal(ID("AAAAAAAA"), id)
+
+	_, err = gen.New(8)
+	is.Equal(ErrExceededMaxAttempts, err, "A repeated ID should be regenerated until the budget is exhausted")
+}
+
+// TestWithRecentBufferConcurrent tests that concurrent callers cannot both issue the same ID.
+func TestWithRecentBufferConcurrent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// A stalling constant reader always yields the same candidate and keeps the callers
+	// overlapping, so only one caller may succeed.
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&stallingReader{delay: 100 * time.Microsecond}),
+		WithRecentBuffer(4),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a recent buffer")
+
+	var wg sync.WaitGroup
+	var issued atomic.Int32
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := gen.New(8); err == nil {
+				issued.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	is.Equal(int32(1), issued.Load(), "The repeated candidate should be issued exactly once")
+}
+
+// TestRecentBufferEviction tests that the oldest ID is evicted once the ring is full.
+func TestRecentBufferEviction(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := newRecentBuffer(2)
+	r.add("a")
+	r.add("b")
+	is.True(r.contains("a"), "A recent ID should be found")
+	is.Equal([]ID{"a", "b"}, r.snapshot())
+
+	r.add("c")
+	is.False(r.contains("a"), "The oldest ID should be evicted")
+	is.Equal([]ID{"b", "c"}, r.snapshot())
+
+	r.add("a")
+	is.Equal([]ID{"c", "a"}, r.snapshot())
+}
+
+// TestWithRecentBufferBloomMonotonic tests that the recent buffer and Bloom filter hold only IDs
+// that were actually returned, not candidates that were rejected or replaced.
+func TestWithRecentBufferBloomMonotonic(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// The reader yields BB, AA, CC, AB in turn. AA and AB sort before the previous ID, so
+	// they are replaced by the monotonic successor of the previous ID.
+	gen, err := NewGenerator(
+		WithAlphabet("ABCD"),
+		WithRandReader(&cyclicReader{data: []byte{1, 1, 0, 0, 2, 2, 0, 1}}),
+		WithRecentBuffer(8),
+		WithBloomFilter(1024, 3),
+		WithMonotonic(true),
+	)
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	var issued []ID
+	for i := 0; i < 4; i++ {
+		id, err := gen.New(2)
+		is.NoError(err, "New should not return an error")
+		issued = append(issued, id)
+	}
+	is.Equal([]ID{"BB", "BC", "CC", "CD"}, issued)
+
+	g := gen.(*generator)
+	is.Equal(issued, g.recent.snapshot(), "The ring should hold exactly the returned IDs")
+	for _, id := range issued {
+		is.True(g.bloom.contains(id), "Returned ID %q should be recorded in the Bloom filter", id)
+	}
+	for _, id := range []ID{"AA", "AB"} {
+		is.False(g.bloom.contains(id), "Replaced candidate %q should not be recorded", id)
+	}
+}
+
+// TestWithRecentBufferInvalid tests rejection of a negative buffer size.
+func TestWithRecentBufferInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := NewGenerator(WithRecentBuffer(-1))
+	is.Equal(ErrInvalidCount, err, "Expected ErrInvalidCount for a negative size")
+}
+
+// snapshot returns a copy of the IDs in the ring, oldest first.
+func (r *recentBuffer) snapshot() []ID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append(slices.Clone(r.ids[r.next:]), r.ids[:r.next]...)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+// RegionOf extracts the region code embedded at the start of an ID generated with WithRegionCode.
+//
+// The code is recovered by position: the first N characters of the ID are returned, where N is
+// the width of the generator's configured region code. This allows a generator in one region to
+// identify IDs minted by generators in other regions, provided all codes share the same width.
+//
+// Parameters:
+//   - id ID: The ID to inspect.
+//
+// Returns:
+//   - string: The region code embedded in the ID.
+//   - error: An error object if the region code cannot be recovered.
+//
+// Error Conditions:
+//   - ErrNoRegionCode: Returned if the generator has no region code configured.
+//   - ErrInvalidAffix: Returned if the ID does not carry the configured prefix and suffix.
+//   - ErrVersionMismatch: Returned if the ID does not carry the configured version character.
+//   - ErrInvalidLength: Returned if the ID is too short to contain a region code and a body.
+//   - ErrInvalidRegionCode: Returned if the embedded code contains characters outside the alphabet.
+//
+// Usage Example:
+//
+//	region, err := generator.RegionOf(id)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Region:", region)
+func (g *generator) RegionOf(id ID) (string, error) {
+	width := len([]rune(g.config.regionCode))
+	if width == 0 {
+		return "", ErrNoRegionCode
+	}
+
+	s, err := g.trimFixed(string(id))
+	if err != nil {
+		return "", err
+	}
+
+	runes := []rune(s)
+	if len(runes) <= width {
+		return "", ErrInvalidLength
+	}
+
+	code := runes[:width]
+	for _, r := range code {
+		if _, ok := g.config.runeIndex[r]; !ok {
+			return "", ErrInvalidRegionCode
+		}
+	}
+
+	return string(code), nil
+}
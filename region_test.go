@@ -0,0 +1,80 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithRegionCode tests generating IDs with a region code and recovering it.
+func TestWithRegionCode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithRegionCode("eu1"))
+	is.NoError(err, "NewGenerator() should not return an error with a valid region code")
+	is.Equal("eu1", gen.(Configuration).Config().(ExtendedConfig).RegionCode(), "Config.RegionCode should match the configured code")
+
+	for i := 0; i < 100; i++ {
+		id, err := gen.New(DefaultLength)
+		is.NoError(err, "New should not return an error")
+		is.Equal(len("eu1")+DefaultLength, len(id), "Region code should be prepended to the random portion")
+		is.True(strings.HasPrefix(string(id), "eu1"), "Generated ID should start with the region code")
+
+		region, err := gen.(Inspector).RegionOf(id)
+		is.NoError(err, "RegionOf should not return an error")
+		is.Equal("eu1", region, "RegionOf should recover the region code")
+
+		is.NoError(gen.(Validator).ValidateStrict(id, DefaultLength), "ValidateStrict should accept an ID with the region code")
+	}
+
+	// A generator in one region can identify IDs minted in another region with the same code width.
+	other, err := NewGenerator(WithRegionCode("us2"))
+	is.NoError(err, "NewGenerator() should not return an error with a valid region code")
+	id, err := other.New(DefaultLength)
+	is.NoError(err, "New should not return an error")
+	region, err := gen.(Inspector).RegionOf(id)
+	is.NoError(err, "RegionOf should not return an error")
+	is.Equal("us2", region, "RegionOf should recover a foreign region code")
+	is.Equal(ErrInvalidRegionCode, gen.(Validator).ValidateStrict(id, DefaultLength), "ValidateStrict should reject a foreign region code")
+}
+
+// TestWithRegionCodeInvalid tests that invalid region codes and IDs are rejected.
+func TestWithRegionCodeInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := NewGenerator(WithRegionCode("eu!"))
+	is.Equal(ErrInvalidRegionCode, err, "Expected ErrInvalidRegionCode for a code outside the alphabet")
+
+	gen, err := NewGenerator(WithRegionCode("eu1"))
+	is.NoError(err, "NewGenerator() should not return an error with a valid region code")
+
+	_, err = gen.(Inspector).RegionOf("eu1")
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for an ID without a body")
+
+	_, err = gen.(Inspector).RegionOf("e!1abc")
+	is.Equal(ErrInvalidRegionCode, err, "Expected ErrInvalidRegionCode for an embedded code outside the alphabet")
+
+	_, err = Generator.(Inspector).RegionOf(Must())
+	is.Equal(ErrNoRegionCode, err, "Expected ErrNoRegionCode for a generator without a region code")
+}
+
+// TestWithRegionCodeMaxBytes tests that NewMaxBytes reserves room for the region code.
+func TestWithRegionCodeMaxBytes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithRegionCode("eu1"))
+	is.NoError(err, "NewGenerator() should not return an error with a valid region code")
+
+	id, err := gen.(MaxBytesGenerator).NewMaxBytes(32)
+	is.NoError(err, "NewMaxBytes should not return an error")
+	is.Equal(32, len(id), "Generated ID including the region code should fill the byte budget")
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// hasNoConsecutiveRepeats reports whether no two adjacent characters of id are identical.
+func hasNoConsecutiveRepeats(id ID) bool {
+	prev := utf8.RuneError
+	for i, r := range string(id) {
+		if i > 0 && r == prev {
+			return false
+		}
+		prev = r
+	}
+	return true
+}
+
+// resampleRepeats redraws every character of id that equals its predecessor, returning
+// ErrExceededMaxAttempts if a character cannot be made distinct within the attempt budget.
+func (g *generator) resampleRepeats(reader io.Reader, id ID) (ID, error) {
+	if hasNoConsecutiveRepeats(id) {
+		return id, nil
+	}
+
+	runes := []rune(id)
+	for i := 1; i < len(runes); i++ {
+		for attempts := 0; runes[i] == runes[i-1]; attempts++ {
+			if attempts == g.config.maxAttempts {
+				return EmptyID, ErrExceededMaxAttempts
+			}
+
+			c, err := g.newFrom(reader, 1)
+			if err != nil {
+				return EmptyID, err
+			}
+			runes[i], _ = utf8.DecodeRuneInString(string(c))
+		}
+	}
+
+	return ID(runes), nil
+}
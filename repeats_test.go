@@ -0,0 +1,76 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithNoConsecutiveRepeats tests that generated IDs never contain adjacent identical characters.
+func TestWithNoConsecutiveRepeats(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	const alphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+	gen, err := NewGenerator(WithAlphabet(alphabet), WithNoConsecutiveRepeats(true))
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.True(gen.(Configuration).Config().(ExtendedConfig).NoConsecutiveRepeats(), "Config should report the setting")
+
+	for i := 0; i < 1000; i++ {
+		id, err := gen.New(16)
+		is.NoError(err, "New should not return an error")
+		is.Len(id, 16)
+		is.True(isValidID(id, alphabet), "Generated ID contains invalid characters")
+		is.True(hasNoConsecutiveRepeats(id), "ID %q should not contain adjacent repeats", id)
+	}
+
+	// Small alphabets are re-sampled rather than rejected wholesale.
+	digits, err := NewGenerator(WithAlphabet("0123456789"), WithNoConsecutiveRepeats(true), WithLeadingAlphabet("123"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	for i := 0; i < 200; i++ {
+		id, err := digits.New(DefaultLength)
+		is.NoError(err, "New should not return an error for a decimal alphabet")
+		is.True(hasNoConsecutiveRepeats(id), "ID %q should not contain adjacent repeats", id)
+	}
+
+	gen, err = NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error")
+	is.False(gen.(Configuration).Config().(ExtendedConfig).NoConsecutiveRepeats(), "Repeats should be allowed by default")
+}
+
+// TestWithNoConsecutiveRepeatsExhausted tests that an unsatisfiable constraint exhausts the attempt budget.
+func TestWithNoConsecutiveRepeatsExhausted(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// A reader that always yields index 0 can never produce a distinct neighbor.
+	gen, err := NewGenerator(
+		WithAlphabet("AB"),
+		WithRandReader(&cyclicReader{data: []byte{0}}),
+		WithNoConsecutiveRepeats(true),
+	)
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.New(4)
+	is.Equal(ErrExceededMaxAttempts, err, "Expected ErrExceededMaxAttempts when repeats cannot be avoided")
+}
+
+// TestHasNoConsecutiveRepeats tests the adjacency check.
+func TestHasNoConsecutiveRepeats(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.True(hasNoConsecutiveRepeats(EmptyID))
+	is.True(hasNoConsecutiveRepeats("A"))
+	is.True(hasNoConsecutiveRepeats("ABAB"))
+	is.True(hasNoConsecutiveRepeats("αβα"))
+	is.False(hasNoConsecutiveRepeats("ABBA"))
+	is.False(hasNoConsecutiveRepeats("αα"))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"hash/fnv"
+)
+
+// RingGenerator is implemented by generators that can generate IDs together with their position on
+// a consistent-hashing ring.
+type RingGenerator interface {
+	// NewWithRingPosition generates a new Nano ID and returns its position on a consistent hash
+	// ring of 'ringSize' slots, computed as the 64-bit FNV-1a hash of the ID modulo ringSize.
+	//
+	// Usage:
+	//   id, pos, err := generator.NewWithRingPosition(1024, 21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   shard := shards[pos]
+	NewWithRingPosition(ringSize uint64, length int) (ID, uint64, error)
+}
+
+// NewWithRingPosition generates a Nano ID of the specified length together with its position on
+// a consistent hash ring of 'ringSize' slots, so callers can route the ID without hashing it again.
+//
+// The position is the 64-bit FNV-1a hash (hash/fnv.New64a) of the complete ID, including any
+// prefix or region code, reduced modulo ringSize. Other services can compute the same position
+// from the ID alone using that hash.
+//
+// Parameters:
+//   - ringSize uint64: The number of positions on the ring. Must be positive.
+//   - length int: The desired number of characters in the generated Nano ID.
+//
+// Returns:
+//   - ID: The generated Nano ID.
+//   - uint64: The ID's position on the ring, in [0, ringSize).
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrInvalidRingSize: Returned if ringSize is zero.
+//   - ErrInvalidLength: Returned if the provided length is less than or equal to zero.
+//
+// Usage Example:
+//
+//	id, pos, err := generator.NewWithRingPosition(1024, 21)
+//	if err != nil {
+//	    // handle error
+//	}
+//	shard := shards[pos]
+func (g *generator) NewWithRingPosition(ringSize uint64, length int) (ID, uint64, error) {
+	if ringSize == 0 {
+		return EmptyID, 0, ErrInvalidRingSize
+	}
+
+	id, err := g.New(length)
+	if err != nil {
+		return EmptyID, 0, err
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(id))
+
+	return id, h.Sum64() % ringSize, nil
+}
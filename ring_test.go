@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"hash/fnv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_NewWithRingPosition tests that the returned position matches an independent hash of the ID.
+func TestGenerator_NewWithRingPosition(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithPrefix("usr_"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	const ringSize = 97
+
+	for i := 0; i < 100; i++ {
+		id, pos, err := gen.(RingGenerator).NewWithRingPosition(ringSize, DefaultLength)
+		is.NoError(err, "NewWithRingPosition should not return an error")
+		is.Less(pos, uint64(ringSize), "The position should be on the ring")
+
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(id))
+		is.Equal(h.Sum64()%ringSize, pos, "The position should equal the FNV-1a hash of the ID modulo the ring size")
+	}
+
+	_, pos, err := gen.(RingGenerator).NewWithRingPosition(1, DefaultLength)
+	is.NoError(err, "NewWithRingPosition should not return an error for a single-slot ring")
+	is.Zero(pos)
+}
+
+// TestGenerator_NewWithRingPositionErrors tests invalid ring sizes and lengths.
+func TestGenerator_NewWithRingPositionErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, _, err := Generator.(RingGenerator).NewWithRingPosition(0, DefaultLength)
+	is.Equal(ErrInvalidRingSize, err, "Expected ErrInvalidRingSize for an empty ring")
+
+	_, _, err = Generator.(RingGenerator).NewWithRingPosition(16, 0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for a zero length")
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"encoding/binary"
+	"io"
+	"math/big"
+	"sync"
+	"sync/atomic"
+)
+
+// SequentialGenerator is implemented by generators that can issue reversible, non-repeating
+// sequential IDs.
+type SequentialGenerator interface {
+	// NextSequential returns a compact, fixed-width ID encoding the next value of the generator's
+	// internal counter, permuted so that consecutive IDs do not look sequential.
+	//
+	// Usage:
+	//   id, err := generator.NextSequential()
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Sequential ID:", id)
+	NextSequential() (ID, error)
+
+	// DecodeSequential recovers the counter value from an ID produced by NextSequential
+	// on the same generator instance.
+	//
+	// Usage:
+	//   n, err := generator.DecodeSequential(id)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Sequence number:", n)
+	DecodeSequential(id ID) (uint64, error)
+}
+
+const (
+	// sequentialBits is the width of the counter encoded by NextSequential.
+	sequentialBits = 64
+
+	// feistelRounds is the number of rounds in the counter permutation.
+	feistelRounds = 4
+)
+
+// sequence holds the per-generator state behind NextSequential and DecodeSequential.
+type sequence struct {
+	counter atomic.Uint64
+
+	// mu serializes drawing the round keys; ready is set once they have been drawn.
+	mu    sync.Mutex
+	ready atomic.Bool
+	keys  [feistelRounds]uint64
+}
+
+// NextSequential returns an ID encoding the next value of the generator's internal counter.
+//
+// The counter starts at zero and is incremented atomically, so IDs are unique per generator
+// instance and decode to a contiguous sequence with DecodeSequential. Before encoding, the
+// counter is passed through a four-round Feistel permutation over its two 32-bit halves so that
+// consecutive IDs do not look sequential. The round keys are drawn from the generator's random
+// reader on first use and never leave the instance, so only the generator that produced an ID
+// can decode it. The permutation obscures ordering but is not encryption: it must not be relied
+// upon to keep counter values secret from a determined adversary.
+//
+// IDs have a fixed width of ceil(64 / log2(alphabetLen)) characters (11 for the default alphabet).
+// Options that shape random IDs, such as WithLeadingAlphabet or WithRegionCode, do not apply.
+//
+// Returns:
+//   - ID: The encoded, permuted counter value.
+//   - error: An error object if the round keys could not be read.
+//
+// Usage Example:
+//
+//	id, err := generator.NextSequential()
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Sequential ID:", id)
+func (g *generator) NextSequential() (ID, error) {
+	if err := g.sequenceKeys(); err != nil {
+		return EmptyID, err
+	}
+
+	n := g.sequence.counter.Add(1) - 1
+	v := new(big.Int).SetUint64(feistelEncrypt(n, &g.sequence.keys))
+	return encodeBig(v, g.config.runeAlphabet, encodedWidth(sequentialBits, int(g.config.alphabetLen))), nil
+}
+
+// DecodeSequential recovers the counter value encoded in an ID produced by NextSequential
+// on the same generator instance.
+//
+// Parameters:
+//   - id ID: An ID produced by NextSequential.
+//
+// Returns:
+//   - uint64: The counter value the ID was generated from.
+//   - error: An error object if the ID is not a valid sequential ID.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if the ID does not have the fixed encoded width.
+//   - ErrInvalidCharacter: Returned if the ID contains a character outside the alphabet.
+//   - ErrValueOutOfRange: Returned if the decoded value exceeds 64 bits.
+//
+// Usage Example:
+//
+//	n, err := generator.DecodeSequential(id)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Sequence number:", n)
+func (g *generator) DecodeSequential(id ID) (uint64, error) {
+	if len([]rune(id)) != encodedWidth(sequentialBits, int(g.config.alphabetLen)) {
+		return 0, ErrInvalidLength
+	}
+
+	v, err := decodeBig(id, int(g.config.alphabetLen), g.config.runeIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.BitLen() > sequentialBits {
+		return 0, ErrValueOutOfRange
+	}
+
+	if err = g.sequenceKeys(); err != nil {
+		return 0, err
+	}
+
+	return feistelDecrypt(v.Uint64(), &g.sequence.keys), nil
+}
+
+// sequenceKeys draws the Feistel round keys from the random reader on first use. If the reader
+// fails, the error is returned and the keys are drawn again on the next call.
+func (g *generator) sequenceKeys() error {
+	if g.sequence.ready.Load() {
+		return nil
+	}
+
+	g.sequence.mu.Lock()
+	defer g.sequence.mu.Unlock()
+
+	if g.sequence.ready.Load() {
+		return nil
+	}
+
+	var buf [feistelRounds * 8]byte
+	if _, err := io.ReadFull(g.config.randReader, buf[:]); err != nil {
+		return err
+	}
+
+	for i := range g.sequence.keys {
+		g.sequence.keys[i] = binary.LittleEndian.Uint64(buf[i*8:])
+	}
+	g.sequence.ready.Store(true)
+
+	return nil
+}
+
+// feistelEncrypt permutes v using a balanced Feistel network over its 32-bit halves.
+func feistelEncrypt(v uint64, keys *[feistelRounds]uint64) uint64 {
+	l, r := uint32(v>>32), uint32(v)
+	for i := 0; i < feistelRounds; i++ {
+		l, r = r, l^feistelRound(r, keys[i])
+	}
+	return uint64(l)<<32 | uint64(r)
+}
+
+// feistelDecrypt inverts feistelEncrypt by applying the rounds in reverse order.
+func feistelDecrypt(v uint64, keys *[feistelRounds]uint64) uint64 {
+	l, r := uint32(v>>32), uint32(v)
+	for i := feistelRounds - 1; i >= 0; i-- {
+		l, r = r^feistelRound(l, keys[i]), l
+	}
+	return uint64(l)<<32 | uint64(r)
+}
+
+// feistelRound is the keyed round function: a 64-bit multiply-xorshift mix of the half-block and key.
+func feistelRound(half uint32, key uint64) uint32 {
+	x := uint64(half) ^ key
+	x *= 0x9e3779b97f4a7c15
+	x ^= x >> 32
+	return uint32(x)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNextSequential tests that sequential IDs decode to a contiguous sequence without looking sequential.
+func TestNextSequential(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	const count = 1000
+	ids := make([]ID, count)
+	for i := range ids {
+		ids[i], err = gen.(SequentialGenerator).NextSequential()
+		is.NoError(err, "NextSequential should not return an error")
+		is.Len(ids[i], 11, "Sequential IDs should have a fixed width")
+		is.True(isValidID(ids[i], DefaultAlphabet), "Generated ID contains invalid characters")
+	}
+
+	for i, id := range ids {
+		n, err := gen.(SequentialGenerator).DecodeSequential(id)
+		is.NoError(err, "DecodeSequential should not return an error")
+		is.Equal(uint64(i), n, "Decoded values should form a contiguous sequence")
+	}
+
+	is.False(sort.SliceIsSorted(ids, func(i, j int) bool { return ids[i] < ids[j] }),
+		"Sequential IDs should not sort in generation order")
+}
+
+// TestDecodeSequentialErrors tests DecodeSequential with malformed input.
+func TestDecodeSequentialErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := Generator.(SequentialGenerator).DecodeSequential("abc")
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for the wrong width")
+
+	_, err = Generator.(SequentialGenerator).DecodeSequential("abcdefghij!")
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a character outside the alphabet")
+
+	maxDigit := DefaultAlphabet[len(DefaultAlphabet)-1:]
+	_, err = Generator.(SequentialGenerator).DecodeSequential(ID(strings.Repeat(maxDigit, 11)))
+	is.Equal(ErrValueOutOfRange, err, "Expected ErrValueOutOfRange for a value wider than 64 bits")
+}
+
+// TestNextSequentialReaderError tests that a failing random reader is reported.
+func TestNextSequentialReaderError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithRandReader(&errorReader{}))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.(SequentialGenerator).NextSequential()
+	is.Error(err, "NextSequential should report a failing random reader")
+}
+
+// flakyReader fails its first 'failures' reads and then delegates to RandReader.
+type flakyReader struct {
+	failures int
+}
+
+func (r *flakyReader) Read(p []byte) (int, error) {
+	if r.failures > 0 {
+		r.failures--
+		return 0, errors.New("transient read error")
+	}
+	return io.ReadFull(RandReader, p)
+}
+
+// TestNextSequentialShortReads tests that round keys are read in full from a reader that
+// returns fewer bytes than requested.
+func TestNextSequentialShortReads(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithRandReader(iotest.OneByteReader(RandReader)))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id, err := gen.(SequentialGenerator).NextSequential()
+	is.NoError(err, "NextSequential should tolerate short reads")
+
+	n, err := gen.(SequentialGenerator).DecodeSequential(id)
+	is.NoError(err, "DecodeSequential should not return an error")
+	is.Equal(uint64(0), n)
+}
+
+// TestNextSequentialTransientError tests that a failed key read is retried on the next call
+// rather than breaking the generator for its lifetime.
+func TestNextSequentialTransientError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithRandReader(&flakyReader{failures: 1}))
+	is.NoError(err, "NewGenerator() should not return an error")
+	seq := gen.(SequentialGenerator)
+
+	_, err = seq.NextSequential()
+	is.Error(err, "The first call should report the reader failure")
+
+	id, err := seq.NextSequential()
+	is.NoError(err, "A later call should draw the keys again")
+
+	n, err := seq.DecodeSequential(id)
+	is.NoError(err, "DecodeSequential should not return an error")
+	is.Equal(uint64(0), n, "The failed call should not consume a counter value")
+}
+
+// TestFeistelRoundTrip tests that the Feistel permutation is invertible.
+func TestFeistelRoundTrip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	keys := [feistelRounds]uint64{1, 2, 3, 4}
+	for _, v := range []uint64{0, 1, 42, 1 << 32, 1<<64 - 1} {
+		is.Equal(v, feistelDecrypt(feistelEncrypt(v, &keys), &keys), "Feistel permutation should round-trip %d", v)
+	}
+}
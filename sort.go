@@ -0,0 +1,41 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"slices"
+)
+
+// IDSlice attaches the methods of sort.Interface to []ID, sorting in increasing
+// lexicographic order as defined by ID.Compare.
+//
+// Usage:
+//
+//	sort.Sort(nanoid.IDSlice(ids))
+type IDSlice []ID
+
+// Len returns the number of IDs in the slice.
+func (x IDSlice) Len() int { return len(x) }
+
+// Less reports whether the ID at index i sorts before the ID at index j.
+func (x IDSlice) Less(i, j int) bool { return x[i].Compare(x[j]) < 0 }
+
+// Swap swaps the IDs at indexes i and j.
+func (x IDSlice) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+
+// SortIDs sorts ids in place in increasing lexicographic order as defined by ID.Compare.
+// The sort is stable, which is useful for deterministic test output and ordered sets.
+//
+// Usage:
+//
+//	ids := []nanoid.ID{"c", "a", "b"}
+//	nanoid.SortIDs(ids)
+//	fmt.Println(ids) // Output: [a b c]
+func SortIDs(ids []ID) {
+	slices.SortStableFunc(ids, func(a, b ID) int {
+		return a.Compare(b)
+	})
+}
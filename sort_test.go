@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSortIDs tests that SortIDs orders IDs consistently with ID.Compare.
+func TestSortIDs(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ids := make([]ID, 500)
+	for i := range ids {
+		ids[i] = Must()
+	}
+	ids = append(ids, ids[0], EmptyID)
+
+	SortIDs(ids)
+	for i := 1; i < len(ids); i++ {
+		is.LessOrEqual(ids[i-1].Compare(ids[i]), 0, "IDs %q and %q are out of order", ids[i-1], ids[i])
+	}
+	is.Equal(EmptyID, ids[0], "EmptyID should sort first")
+
+	mixed := []ID{"b", "B", "a", "_", "-", "0", "ab"}
+	SortIDs(mixed)
+	is.Equal([]ID{"-", "0", "B", "_", "a", "ab", "b"}, mixed, "SortIDs should use byte-wise lexicographic order")
+}
+
+// TestIDSlice tests the sort.Interface adapter.
+func TestIDSlice(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ids := IDSlice{"c", "a", "b", "a"}
+	is.Equal(4, ids.Len())
+	is.True(ids.Less(1, 0), "a should sort before c")
+	is.False(ids.Less(1, 3), "Equal IDs should not be less than each other")
+
+	ids.Swap(0, 1)
+	is.Equal(IDSlice{"a", "c", "b", "a"}, ids)
+
+	sort.Sort(ids)
+	is.Equal(IDSlice{"a", "a", "b", "c"}, ids)
+
+	expected := []ID{"x", "m", "a"}
+	SortIDs(expected)
+	actual := IDSlice{"x", "m", "a"}
+	sort.Stable(actual)
+	is.Equal(expected, []ID(actual), "IDSlice should order IDs the same way as SortIDs")
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math/big"
+	"time"
+)
+
+// timestampPrefix encodes the low-order TimePrefixBytes bytes of t as Unix milliseconds,
+// using the alphabet in code point order so that the encoding sorts like the timestamp.
+func (g *generator) timestampPrefix(t time.Time) ID {
+	ms := uint64(t.UnixMilli())
+	if bits := 8 * g.config.timePrefixBytes; bits < 64 {
+		ms &= 1<<bits - 1
+	}
+
+	return encodeBig(new(big.Int).SetUint64(ms), g.config.sortedAlphabet, g.config.timePrefixWidth)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWithTimePrefix tests that IDs generated in sequence are non-decreasing by Compare.
+func TestWithTimePrefix(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// The default alphabet is not in code point order, so this also exercises the sorted encoding.
+	gen, err := NewGenerator(WithTimePrefix(6))
+	is.NoError(err, "NewGenerator() should not return an error with a time prefix")
+	is.Equal(8, gen.(Configuration).Config().(ExtendedConfig).TimePrefixWidth(), "48 bits should take 8 characters of a 64-character alphabet")
+
+	prev, err := gen.New(DefaultLength)
+	is.NoError(err, "New should not return an error")
+	is.Len(prev, DefaultLength, "The timestamp should count toward the length")
+
+	for i := 0; i < 50; i++ {
+		if i%10 == 0 {
+			time.Sleep(2 * time.Millisecond)
+		}
+
+		id, err := gen.New(DefaultLength)
+		is.NoError(err, "New should not return an error")
+		is.True(isValidID(id, DefaultAlphabet), "Generated ID contains invalid characters")
+		prevTime, idTime := prev[:8], id[:8]
+		is.LessOrEqual(prevTime.Compare(idTime), 0, "Timestamps should be non-decreasing: %q then %q", prev, id)
+		is.NoError(gen.(Validator).ValidateStrict(id, DefaultLength), "ValidateStrict should accept a time-prefixed ID")
+		prev = id
+	}
+}
+
+// TestWithTimePrefixMonotonic tests that combining the time prefix with WithMonotonic orders every ID.
+func TestWithTimePrefixMonotonic(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithTimePrefix(6), WithMonotonic(true))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	prev, err := gen.New(16)
+	is.NoError(err, "New should not return an error")
+
+	for i := 0; i < 500; i++ {
+		id, err := gen.New(16)
+		is.NoError(err, "New should not return an error")
+		is.Negative(prev.Compare(id), "IDs should strictly increase: %q then %q", prev, id)
+		prev = id
+	}
+}
+
+// TestGenerator_TimestampPrefix tests that the encoding sorts like the timestamp it encodes.
+func TestGenerator_TimestampPrefix(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithAlphabet("zyxwvutsrqponmlkjihgfedcba"), WithTimePrefix(6))
+	is.NoError(err, "NewGenerator() should not return an error")
+	g := gen.(*generator)
+
+	base := time.UnixMilli(1_700_000_000_000)
+	prev := g.timestampPrefix(base)
+	for _, d := range []time.Duration{time.Millisecond, time.Second, time.Hour, 24 * time.Hour * 365} {
+		next := g.timestampPrefix(base.Add(d))
+		is.Negative(prev.Compare(next), "Later timestamps should sort after earlier ones")
+		prev = next
+	}
+
+	// Fewer bytes wrap around: 1 byte holds 256 milliseconds.
+	gen, err = NewGenerator(WithTimePrefix(1))
+	is.NoError(err, "NewGenerator() should not return an error")
+	g = gen.(*generator)
+	is.Equal(g.timestampPrefix(time.UnixMilli(5)), g.timestampPrefix(time.UnixMilli(261)), "Timestamps should wrap modulo 2^8")
+}
+
+// TestWithTimePrefixInvalid tests rejected time prefix configurations and lengths.
+func TestWithTimePrefixInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, bytes := range []int{-1, 9} {
+		_, err := NewGenerator(WithTimePrefix(bytes))
+		is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for %d bytes", bytes)
+	}
+
+	_, err := NewGenerator(WithTimePrefix(6), WithHybridLayout(8))
+	is.Equal(ErrIncompatibleOptions, err, "Expected ErrIncompatibleOptions with the hybrid layout")
+
+	gen, err := NewGenerator(WithTimePrefix(6))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.New(8)
+	is.Equal(ErrLengthTooShort, err, "Expected ErrLengthTooShort without room for a random character")
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"context"
+	"io"
+)
+
+// StreamGenerator is implemented by generators that can write a stream of IDs to an io.Writer.
+type StreamGenerator interface {
+	// GenerateTo streams newline-delimited Nano IDs of the specified length to w until ctx is done,
+	// returning the number of bytes written. Cancellation is the expected way to stop and is not an error.
+	//
+	// Usage:
+	//   n, err := generator.GenerateTo(ctx, file, 21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Printf("Wrote %d bytes of IDs\n", n)
+	GenerateTo(ctx context.Context, w io.Writer, length int) (int64, error)
+}
+
+// GenerateTo streams newline-delimited Nano IDs of the specified length to w until ctx is done,
+// returning the number of bytes written.
+//
+// The context is checked before each ID is generated, so GenerateTo stops promptly once it is
+// cancelled or its deadline passes; every line written is a complete ID followed by '\n'.
+// Because cancellation is the expected way to stop the stream, it is not reported as an error.
+// IDs are generated into a reused buffer, keeping per-ID allocations to a minimum.
+//
+// Parameters:
+//   - ctx context.Context: Controls how long IDs are streamed.
+//   - w io.Writer: The destination for the newline-delimited IDs.
+//   - length int: The desired number of characters in each generated Nano ID.
+//
+// Returns:
+//   - int64: The number of bytes written to w.
+//   - error: An error object if generation or writing fails.
+//
+// Usage Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	n, err := generator.GenerateTo(ctx, file, 21)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Printf("Wrote %d bytes of IDs\n", n)
+func (g *generator) GenerateTo(ctx context.Context, w io.Writer, length int) (int64, error) {
+	if w == nil {
+		return 0, ErrNilPointer
+	}
+
+	var (
+		buf     []byte
+		written int64
+	)
+
+	for ctx.Err() == nil {
+		id, err := g.NewInto(&buf, length)
+		if err != nil {
+			return written, err
+		}
+
+		buf = append(buf[:len(id)], '\n')
+		n, err := w.Write(buf)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
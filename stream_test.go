@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_GenerateTo tests streaming IDs to a buffer until the context deadline passes.
+func TestGenerator_GenerateTo(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var out bytes.Buffer
+	n, err := gen.(StreamGenerator).GenerateTo(ctx, &out, DefaultLength)
+	is.NoError(err, "GenerateTo should not return an error when the context is cancelled")
+	is.Equal(int64(out.Len()), n, "GenerateTo should report the number of bytes written")
+	is.Positive(n, "GenerateTo should write IDs before the deadline")
+
+	output := out.String()
+	is.True(strings.HasSuffix(output, "\n"), "Output should end with a complete line")
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	for _, line := range lines {
+		is.Equal(DefaultLength, len(line), "Each line should be a complete ID")
+		is.True(isValidID(ID(line), DefaultAlphabet), "Each line should contain only valid characters")
+	}
+}
+
+// TestGenerator_GenerateToErrors tests that GenerateTo surfaces writer and generation errors.
+func TestGenerator_GenerateToErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	_, err = gen.(StreamGenerator).GenerateTo(context.Background(), &failingWriter{}, DefaultLength)
+	is.EqualError(err, "simulated write error", "GenerateTo should return the writer's error")
+
+	_, err = gen.(StreamGenerator).GenerateTo(context.Background(), &bytes.Buffer{}, 0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+
+	_, err = gen.(StreamGenerator).GenerateTo(context.Background(), nil, DefaultLength)
+	is.Equal(ErrNilPointer, err, "Expected ErrNilPointer for a nil writer")
+}
+
+// failingWriter is an io.Writer that always returns an error.
+type failingWriter struct{}
+
+// Write implements the io.Writer interface and always returns an error.
+func (f *failingWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("simulated write error")
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math/big"
+	"unicode/utf8"
+)
+
+// Transcode re-encodes an ID from one alphabet into another while preserving its numeric value,
+// for migrating IDs between schemes or interoperating with systems that expect a different alphabet.
+//
+// The ID is read as a number in the base of 'from', most significant character first, and written
+// in the base of 'to'. Leading zero digits (the first character of 'from') are preserved as
+// leading zero digits (the first character of 'to'), as in base58, so IDs that differ only in
+// their leading zeros stay distinct and transcoding back restores the original ID.
+//
+// Parameters:
+//   - id ID: The ID to transcode.
+//   - from string: The alphabet the ID is written in.
+//   - to string: The alphabet to write the ID in.
+//
+// Returns:
+//   - ID: The transcoded ID.
+//   - error: An error object if the ID or alphabets are invalid.
+//
+// Error Conditions:
+//   - ErrNonUTF8Alphabet: Returned if either alphabet is not valid UTF-8.
+//   - ErrAlphabetTooShort: Returned if either alphabet has fewer than two characters.
+//   - ErrDuplicateCharacters: Returned if either alphabet contains duplicate characters.
+//   - ErrInvalidCharacter: Returned if the ID contains a character outside 'from'.
+//
+// Usage Example:
+//
+//	id, err := nanoid.Transcode("00ff", nanoid.AlphabetHexLower, nanoid.AlphabetBase62)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println(id) // Output: 0047
+func Transcode(id ID, from, to string) (ID, error) {
+	fromRunes, fromIndex, err := transcodeAlphabet(from)
+	if err != nil {
+		return EmptyID, err
+	}
+
+	toRunes, _, err := transcodeAlphabet(to)
+	if err != nil {
+		return EmptyID, err
+	}
+
+	// Count leading zero digits, which carry no numeric value but are part of the ID.
+	zeros := 0
+	for _, r := range string(id) {
+		if r != fromRunes[0] {
+			break
+		}
+		zeros++
+	}
+
+	n, err := decodeBig(id, len(fromRunes), fromIndex)
+	if err != nil {
+		return EmptyID, err
+	}
+
+	// encodeBig renders zero as no digits, so the leading zeros alone represent it.
+	return encodeBig(new(big.Int), toRunes, zeros) + encodeBig(n, toRunes, 0), nil
+}
+
+// transcodeAlphabet validates an alphabet for Transcode, returning its runes and index.
+func transcodeAlphabet(alphabet string) ([]rune, map[rune]int, error) {
+	if !utf8.ValidString(alphabet) {
+		return nil, nil, ErrNonUTF8Alphabet
+	}
+
+	runes := []rune(alphabet)
+	if len(runes) < MinAlphabetLength {
+		return nil, nil, ErrAlphabetTooShort
+	}
+
+	index := make(map[rune]int, len(runes))
+	for i, r := range runes {
+		if _, ok := index[r]; ok {
+			return nil, nil, ErrDuplicateCharacters
+		}
+		index[r] = i
+	}
+
+	return runes, index, nil
+}
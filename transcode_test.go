@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTranscode tests conversion between alphabets against known values.
+func TestTranscode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		id       ID
+		from     string
+		to       string
+		expected ID
+	}{
+		{"ff", AlphabetHexLower, "0123456789", "255"},
+		{"00ff", AlphabetHexLower, AlphabetBase62, "0047"},
+		{"255", "0123456789", AlphabetHexUpper, "FF"},
+		{"0", AlphabetHexLower, AlphabetBase62, "0"},
+		{"000", "0123456789", "01", "000"},
+		{"10", "0123456789", "01", "1010"},
+		{EmptyID, AlphabetHexLower, AlphabetBase62, EmptyID},
+		{"βα", "αβγ", "0123456789", "3"},
+	}
+
+	for _, tt := range tests {
+		id, err := Transcode(tt.id, tt.from, tt.to)
+		is.NoError(err, "Transcode(%q) should not return an error", tt.id)
+		is.Equal(tt.expected, id, "Transcode(%q) should preserve the numeric value", tt.id)
+	}
+}
+
+// TestTranscodeRoundTrip tests that transcoding generated IDs there and back restores them.
+func TestTranscodeRoundTrip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithAlphabet(AlphabetHexLower))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	for i := 0; i < 200; i++ {
+		id, err := gen.New(32)
+		is.NoError(err, "New should not return an error")
+
+		// Force leading zeros on some IDs.
+		if i%4 == 0 {
+			id = "00" + id[2:]
+		}
+
+		encoded, err := Transcode(id, AlphabetHexLower, AlphabetBase62)
+		is.NoError(err, "Transcode should not return an error")
+		is.True(isValidID(encoded, AlphabetBase62), "The transcoded ID contains invalid characters")
+
+		decoded, err := Transcode(encoded, AlphabetBase62, AlphabetHexLower)
+		is.NoError(err, "Transcode should not return an error")
+		is.Equal(id, decoded, "Transcoding back should restore the original ID")
+	}
+}
+
+// TestTranscodeErrors tests invalid IDs and alphabets.
+func TestTranscodeErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := Transcode("12g4", AlphabetHexLower, AlphabetBase62)
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a character outside the source alphabet")
+
+	_, err = Transcode("1", "a", AlphabetBase62)
+	is.Equal(ErrAlphabetTooShort, err, "Expected ErrAlphabetTooShort for a one-character alphabet")
+
+	_, err = Transcode("1", AlphabetHexLower, "0120")
+	is.Equal(ErrDuplicateCharacters, err, "Expected ErrDuplicateCharacters for duplicate characters")
+
+	_, err = Transcode("1", "\xff\xfe", AlphabetBase62)
+	is.Equal(ErrNonUTF8Alphabet, err, "Expected ErrNonUTF8Alphabet for invalid UTF-8")
+}
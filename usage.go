@@ -0,0 +1,75 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"io"
+)
+
+// UsageGenerator is implemented by generators that can report the randomness consumed by each ID.
+type UsageGenerator interface {
+	// NewWithUsage generates a Nano ID of the specified length and reports how many bytes
+	// were read from the random source to produce it.
+	//
+	// Usage:
+	//   id, n, err := generator.NewWithUsage(21)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Printf("%s consumed %d random bytes\n", id, n)
+	NewWithUsage(length int) (id ID, bytesConsumed int, err error)
+}
+
+// countingReader wraps an io.Reader and tallies the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+// Read reads from the underlying reader and adds the bytes read to the running total.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+// NewWithUsage generates a Nano ID of the specified length and reports exactly how many bytes
+// were read from the random source to produce it, for precise per-ID entropy accounting.
+//
+// The count includes every byte drawn, including bytes discarded by rejection sampling and by
+// regenerated candidates, so it varies between calls for alphabets whose length is not a power
+// of two. For power-of-two alphabets without constraints it is the same for every ID of a given
+// length. The count reflects the generator's buffered reads, which may exceed the entropy that
+// ends up in the ID.
+//
+// Parameters:
+//   - length int: The desired number of characters in the generated Nano ID.
+//
+// Returns:
+//   - ID: The generated Nano ID.
+//   - int: The number of bytes read from the random source.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if the provided length is less than or equal to zero.
+//   - ErrLengthTooShort: Returned if the provided length is below the configured minimum length.
+//
+// Usage Example:
+//
+//	id, n, err := generator.NewWithUsage(21)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Printf("%s consumed %d random bytes\n", id, n)
+func (g *generator) NewWithUsage(length int) (id ID, bytesConsumed int, err error) {
+	if err = g.checkLength(length); err != nil {
+		return EmptyID, 0, err
+	}
+
+	reader := &countingReader{r: g.config.randReader}
+	id, err = g.generate(reader, length)
+	return id, reader.n, err
+}
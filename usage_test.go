@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewWithUsagePowerOfTwo tests that consumption is reported exactly and is constant
+// for a power-of-two alphabet.
+func TestNewWithUsagePowerOfTwo(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	const alphabet = "0123456789abcdef"
+	source := &countingReader{r: RandReader}
+	gen, err := NewGenerator(WithAlphabet(alphabet), WithRandReader(source))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	var first int
+	for i := 0; i < 100; i++ {
+		before := source.n
+		id, n, err := gen.(UsageGenerator).NewWithUsage(DefaultLength)
+		is.NoError(err, "NewWithUsage should not return an error")
+		is.True(isValidID(id, alphabet), "Generated ID contains invalid characters")
+		is.Equal(source.n-before, n, "Reported usage should match the bytes read from the source")
+		is.Positive(n, "Generating an ID should consume random bytes")
+
+		if i == 0 {
+			first = n
+		}
+		is.Equal(first, n, "Consumption should be constant for a power-of-two alphabet")
+	}
+}
+
+// TestNewWithUsageRejection tests that rejected bytes are included in the reported consumption.
+func TestNewWithUsageRejection(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	usage := func(data []byte) int {
+		source := &countingReader{r: &cyclicReader{data: data}}
+		gen, err := NewGenerator(WithAlphabet("ABC"), WithRandReader(source))
+		is.NoError(err, "NewGenerator() should not return an error")
+
+		id, n, err := gen.(UsageGenerator).NewWithUsage(DefaultLength)
+		is.NoError(err, "NewWithUsage should not return an error")
+		is.True(isValidID(id, "ABC"), "Generated ID contains invalid characters")
+		is.Equal(source.n, n, "Reported usage should match the bytes read from the source")
+		return n
+	}
+
+	// For a three-character alphabet the value 3 is out of range and rejected.
+	accepted := usage([]byte{0, 1, 2})
+	rejected := usage([]byte{3, 3, 3, 0})
+	is.Greater(rejected, accepted, "Rejected bytes should increase the reported consumption")
+}
+
+// TestNewWithUsageConstrained tests usage reporting through the constrained generation path.
+func TestNewWithUsageConstrained(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	source := &countingReader{r: RandReader}
+	gen, err := NewGenerator(WithRandReader(source), WithRegionCode("eu"))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id, n, err := gen.(UsageGenerator).NewWithUsage(10)
+	is.NoError(err, "NewWithUsage should not return an error")
+	is.Len(id, 12, "The ID should include the region code")
+	is.Equal(source.n, n, "Reported usage should match the bytes read from the source")
+
+	_, n, err = gen.(UsageGenerator).NewWithUsage(0)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for zero length")
+	is.Zero(n, "No bytes should be consumed for an invalid length")
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"math/big"
+)
+
+// UUIDCodec is implemented by generators that can encode UUIDs into their alphabet and back.
+type UUIDCodec interface {
+	// EncodeUUID encodes a UUID into the alphabet as a fixed-width ID that DecodeUUID reverses.
+	//
+	// Usage:
+	//   id, err := generator.EncodeUUID([16]byte(uuid.New()))
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Short UUID:", id)
+	EncodeUUID(u [16]byte) (ID, error)
+
+	// DecodeUUID decodes an ID produced by EncodeUUID back into the original UUID.
+	//
+	// Usage:
+	//   u, err := generator.DecodeUUID(id)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("UUID:", uuid.UUID(u))
+	DecodeUUID(id ID) ([16]byte, error)
+}
+
+// uuidBits is the number of bits in a UUID.
+const uuidBits = 128
+
+// EncodeUUID encodes a UUID into the generator's alphabet, producing a shorter,
+// URL-friendly representation that can be decoded back with DecodeUUID.
+//
+// The 128-bit value is base-converted into the alphabet and left-padded with the
+// alphabet's first character to a fixed width of ceil(128 / log2(alphabetLen)) characters,
+// so every encoded UUID has the same length (22 characters for the default alphabet).
+//
+// The UUID is taken as its 16 raw bytes, so that this package does not depend on any particular
+// UUID library. Types such as github.com/google/uuid.UUID convert to [16]byte directly.
+//
+// Parameters:
+//   - u [16]byte: The UUID to encode.
+//
+// Returns:
+//   - ID: The encoded UUID.
+//   - error: An error object if the encoding fails.
+//
+// Usage Example:
+//
+//	id, err := generator.EncodeUUID([16]byte(uuid.New()))
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Short UUID:", id)
+func (g *generator) EncodeUUID(u [16]byte) (ID, error) {
+	n := new(big.Int).SetBytes(u[:])
+	return encodeBig(n, g.config.runeAlphabet, encodedWidth(uuidBits, int(g.config.alphabetLen))), nil
+}
+
+// DecodeUUID decodes an ID produced by EncodeUUID back into the original UUID.
+//
+// Parameters:
+//   - id ID: The encoded UUID.
+//
+// Returns:
+//   - [16]byte: The raw bytes of the decoded UUID.
+//   - error: An error object if the ID is not a valid encoded UUID.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if the ID does not have the fixed encoded width.
+//   - ErrInvalidCharacter: Returned if the ID contains a character outside the alphabet.
+//   - ErrValueOutOfRange: Returned if the decoded value exceeds 128 bits.
+//
+// Usage Example:
+//
+//	u, err := generator.DecodeUUID(id)
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("UUID:", uuid.UUID(u))
+func (g *generator) DecodeUUID(id ID) ([16]byte, error) {
+	if len([]rune(id)) != encodedWidth(uuidBits, int(g.config.alphabetLen)) {
+		return [16]byte{}, ErrInvalidLength
+	}
+
+	n, err := decodeBig(id, int(g.config.alphabetLen), g.config.runeIndex)
+	if err != nil {
+		return [16]byte{}, err
+	}
+
+	if n.BitLen() > uuidBits {
+		return [16]byte{}, ErrValueOutOfRange
+	}
+
+	var u [16]byte
+	n.FillBytes(u[:])
+	return u, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_EncodeUUID tests encoding a known UUID to the default alphabet and decoding it back.
+func TestGenerator_EncodeUUID(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	// f47ac10b-58cc-4372-a567-0e02b2c3d479
+	u := [16]byte{
+		0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72,
+		0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79,
+	}
+
+	id, err := gen.(UUIDCodec).EncodeUUID(u)
+	is.NoError(err, "EncodeUUID should not return an error")
+	is.Equal(ID("1OsG29kaL1qEjB1u8MKXfT"), id, "EncodeUUID should produce the expected encoding")
+	is.True(isValidID(id, DefaultAlphabet), "Encoded UUID contains invalid characters")
+
+	decoded, err := gen.(UUIDCodec).DecodeUUID(id)
+	is.NoError(err, "DecodeUUID should not return an error")
+	is.Equal(u, decoded, "DecodeUUID should round-trip the original UUID")
+}
+
+// TestGenerator_EncodeUUIDRoundTrip tests round-tripping random and boundary UUIDs across alphabets.
+func TestGenerator_EncodeUUIDRoundTrip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, alphabet := range []string{DefaultAlphabet, "0123456789abcdef", "abc😊🚀🌟"} {
+		gen, err := NewGenerator(WithAlphabet(alphabet))
+		is.NoError(err, "NewGenerator() should not return an error with a valid alphabet")
+
+		uuids := [][16]byte{{}, {0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}}
+		for i := 0; i < 100; i++ {
+			var u [16]byte
+			_, err := rand.Read(u[:])
+			is.NoError(err, "rand.Read should not return an error")
+			uuids = append(uuids, u)
+		}
+
+		for _, u := range uuids {
+			id, err := gen.(UUIDCodec).EncodeUUID(u)
+			is.NoError(err, "EncodeUUID should not return an error")
+
+			decoded, err := gen.(UUIDCodec).DecodeUUID(id)
+			is.NoError(err, "DecodeUUID should not return an error")
+			is.Equal(u, decoded, "DecodeUUID should round-trip %x", u)
+		}
+	}
+}
+
+// TestGenerator_DecodeUUIDInvalid tests that DecodeUUID rejects malformed input.
+func TestGenerator_DecodeUUIDInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	_, err = gen.(UUIDCodec).DecodeUUID("short")
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+
+	_, err = gen.(UUIDCodec).DecodeUUID(ID(strings.Repeat("!", 22)))
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter")
+
+	_, err = gen.(UUIDCodec).DecodeUUID(ID(strings.Repeat("Z", 22)))
+	is.Equal(ErrValueOutOfRange, err, "Expected ErrValueOutOfRange")
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+	"time"
+)
+
+// Validator is implemented by generators that can check whether strings are well-formed IDs for
+// their configuration.
+type Validator interface {
+	// ValidateStrict checks that the ID has exactly 'expectedLength' characters, all drawn from
+	// the generator's alphabet, returning a distinct error for each kind of failure.
+	//
+	// Usage:
+	//   if err := generator.ValidateStrict(id, 21); err != nil {
+	//       // reject the input
+	//   }
+	ValidateStrict(id ID, expectedLength int) error
+
+	// Parse converts a raw string into an ID if it is well-formed for this generator,
+	// returning an error wrapping ErrInvalidID that identifies the offending character otherwise.
+	//
+	// Usage:
+	//   id, err := generator.Parse(input)
+	//   if errors.Is(err, nanoid.ErrInvalidID) {
+	//       // reject the input
+	//   }
+	Parse(s string) (ID, error)
+}
+
+// Inspector is implemented by generators that can recover the components encoded in an ID, such as
+// its region code or version.
+type Inspector interface {
+	// RegionOf extracts the fixed-width region code embedded at the start of an ID generated
+	// with WithRegionCode.
+	//
+	// Usage:
+	//   region, err := generator.RegionOf(id)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Region:", region)
+	RegionOf(id ID) (string, error)
+
+	// VersionOf returns the schema version encoded in an ID generated with WithVersion.
+	//
+	// Usage:
+	//   v, err := generator.VersionOf(id)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Version:", v)
+	VersionOf(id ID) (byte, error)
+
+	// LengthOf recovers the body length encoded in the leading character of an ID generated
+	// with WithLengthPrefix.
+	//
+	// Usage:
+	//   n, err := generator.LengthOf(id)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Body length:", n)
+	LengthOf(id ID) (int, error)
+
+	// TimeWindow recovers the millisecond timestamp embedded in an ID generated with WithHybridLayout.
+	//
+	// Usage:
+	//   ts, err := generator.TimeWindow(id)
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Generated at:", ts)
+	TimeWindow(id ID) (time.Time, error)
+}
+
+// ValidateStrict checks that an ID fully conforms to the generator's scheme.
+//
+// It verifies that the ID carries the configured prefix and suffix (if any), that it starts with
+// the configured region code (if any), that the length prefix (if any) encodes 'expectedLength',
+// that the random portion has exactly that many characters, and that every character belongs to
+// the alphabet (with the first random character checked against the leading alphabet, when one
+// is configured). Each failure is reported with a distinct error so callers can explain why an ID
+// was rejected.
+//
+// No checksum is verified, because generators have no checksum option and IDs returned by New
+// carry no check character. The Crockford check symbol appended by NewCrockford is separate from
+// the generator's scheme: verify it with ValidateCrockford, then pass the ID without its final
+// character to ValidateStrict.
+//
+// Parameters:
+//   - id ID: The ID to validate.
+//   - expectedLength int: The exact number of characters the ID must contain.
+//
+// Returns:
+//   - error: nil if the ID conforms, otherwise the reason it does not.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if expectedLength is less than or equal to zero.
+//   - ErrInvalidAffix: Returned if the ID does not carry the configured prefix and suffix.
+//   - ErrVersionMismatch: Returned if the ID does not carry the configured version character.
+//   - ErrInvalidRegionCode: Returned if the ID does not start with the configured region code.
+//   - ErrLengthMismatch: Returned if the ID does not have exactly expectedLength characters,
+//     or its length prefix encodes a different length.
+//   - ErrInvalidCharacter: Returned if the ID contains a character outside the alphabet.
+//
+// Usage Example:
+//
+//	if err := generator.ValidateStrict(id, 21); err != nil {
+//	    // reject the input
+//	}
+func (g *generator) ValidateStrict(id ID, expectedLength int) error {
+	if expectedLength <= 0 {
+		return ErrInvalidLength
+	}
+
+	s, err := g.trimFixed(string(id))
+	if err != nil {
+		return err
+	}
+	id = ID(s)
+
+	if g.config.regionCode != "" {
+		if !strings.HasPrefix(string(id), g.config.regionCode) {
+			return ErrInvalidRegionCode
+		}
+		id = id[len(g.config.regionCode):]
+	}
+
+	runes := []rune(string(id))
+	if g.config.isLengthPrefixed {
+		if len(runes) == 0 || g.config.runeIndex[runes[0]] != expectedLength {
+			return ErrLengthMismatch
+		}
+		runes = runes[1:]
+	}
+
+	if len(runes) != expectedLength {
+		return ErrLengthMismatch
+	}
+
+	// The leading alphabet applies to the first random character, after any time prefix.
+	leadingPos := -1
+	if g.leading != nil {
+		leadingPos = g.config.timePrefixWidth
+	}
+
+	for i, r := range runes {
+		index := g.config.runeIndex
+		if i == leadingPos {
+			index = g.leading.config.runeIndex
+		}
+
+		if _, ok := index[r]; !ok {
+			return ErrInvalidCharacter
+		}
+	}
+
+	return nil
+}
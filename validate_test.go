@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_ValidateStrict tests that ValidateStrict accepts conforming IDs.
+func TestGenerator_ValidateStrict(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	id, err := gen.New(DefaultLength)
+	is.NoError(err, "New should not return an error")
+	is.NoError(gen.(Validator).ValidateStrict(id, DefaultLength), "ValidateStrict should accept a generated ID")
+}
+
+// TestGenerator_ValidateStrictLengthMismatch tests that ValidateStrict rejects IDs of the wrong length.
+func TestGenerator_ValidateStrictLengthMismatch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithAlphabet("abc😊"))
+	is.NoError(err, "NewGenerator() should not return an error with a valid custom alphabet")
+
+	is.Equal(ErrLengthMismatch, gen.(Validator).ValidateStrict("abc", 4), "Expected ErrLengthMismatch for a short ID")
+	is.Equal(ErrLengthMismatch, gen.(Validator).ValidateStrict("abc😊a", 4), "Expected ErrLengthMismatch for a long ID")
+	is.NoError(gen.(Validator).ValidateStrict("abc😊", 4), "Length should be measured in characters, not bytes")
+	is.Equal(ErrInvalidLength, gen.(Validator).ValidateStrict("abc", 0), "Expected ErrInvalidLength for a non-positive expected length")
+}
+
+// TestGenerator_ValidateStrictBadCharacter tests that ValidateStrict rejects characters outside the alphabet.
+func TestGenerator_ValidateStrictBadCharacter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(
+		WithAlphabet("0123456789"),
+		WithLeadingAlphabet("xyz"),
+	)
+	is.NoError(err, "NewGenerator() should not return an error with a leading alphabet")
+
+	is.NoError(gen.(Validator).ValidateStrict("x123", 4), "ValidateStrict should accept a conforming ID")
+	is.Equal(ErrInvalidCharacter, gen.(Validator).ValidateStrict("1123", 4), "Expected ErrInvalidCharacter for a bad leading character")
+	is.Equal(ErrInvalidCharacter, gen.(Validator).ValidateStrict("x1a3", 4), "Expected ErrInvalidCharacter for a bad body character")
+}
+
+// TestGenerator_ValidateStrictCrockford tests the documented way to validate an ID that carries
+// a Crockford check symbol: verify the symbol with ValidateCrockford, then the body with
+// ValidateStrict.
+func TestGenerator_ValidateStrictCrockford(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewCrockfordGenerator()
+	is.NoError(err, "NewCrockfordGenerator() should not return an error")
+
+	id, err := gen.(CrockfordGenerator).NewCrockford(12)
+	is.NoError(err, "NewCrockford should not return an error")
+	is.True(ValidateCrockford(id), "The check symbol should be valid")
+	is.NoError(gen.(Validator).ValidateStrict(id[:12], 12), "The body should conform to the scheme")
+
+	// Replace the check symbol with one that cannot be correct for this body.
+	bad := id[:12] + "0"
+	if id[12] == '0' {
+		bad = id[:12] + "1"
+	}
+	is.False(ValidateCrockford(bad), "A bad check symbol should be rejected")
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import "unicode/utf8"
+
+// VersionOf returns the schema version encoded in an ID generated with WithVersion.
+//
+// The version is read from the character following the prefix and may differ from the
+// generator's own version, so a parser can accept IDs from several versions of a scheme
+// and dispatch on the result. VersionOf is the only inspector that accepts other versions:
+// RegionOf, LengthOf, TimeWindow, ValidateStrict and Parse reject them with
+// ErrVersionMismatch, so dispatch to a generator configured with the version found.
+//
+// Parameters:
+//   - id ID: The ID to inspect.
+//
+// Returns:
+//   - byte: The version encoded in the ID.
+//   - error: An error object if the version cannot be recovered.
+//
+// Error Conditions:
+//   - ErrNoVersion: Returned if the generator has no version configured.
+//   - ErrInvalidAffix: Returned if the ID does not carry the configured prefix and suffix.
+//   - ErrInvalidLength: Returned if the ID is too short to contain a version character.
+//   - ErrInvalidCharacter: Returned if the version character is outside the alphabet.
+//
+// Usage Example:
+//
+//	v, err := generator.VersionOf(id)
+//	if err != nil {
+//	    // handle error
+//	}
+//	switch v {
+//	case 1:
+//	    // parse a version 1 ID
+//	}
+func (g *generator) VersionOf(id ID) (byte, error) {
+	if !g.config.isVersioned {
+		return 0, ErrNoVersion
+	}
+
+	s, ok := g.trimAffixes(string(id))
+	if !ok {
+		return 0, ErrInvalidAffix
+	}
+
+	if s == "" {
+		return 0, ErrInvalidLength
+	}
+
+	r, _ := utf8.DecodeRuneInString(s)
+	v, ok := g.config.runeIndex[r]
+	if !ok {
+		return 0, ErrInvalidCharacter
+	}
+
+	return byte(v), nil
+}
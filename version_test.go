@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_VersionOf tests generating versioned IDs and recovering the version.
+func TestGenerator_VersionOf(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithVersion(3))
+	is.NoError(err, "NewGenerator() should not return an error with a version")
+
+	config := gen.(Configuration).Config().(ExtendedConfig)
+	is.True(config.HasVersion(), "Config should report a version")
+	is.Equal(byte(3), config.Version())
+
+	id, err := gen.New(DefaultLength)
+	is.NoError(err, "New should not return an error")
+	is.Len(id, DefaultLength+1, "The version character should not count toward the length")
+	is.Equal(DefaultAlphabet[3], id[0], "The version should be the alphabet character at its index")
+
+	v, err := gen.(Inspector).VersionOf(id)
+	is.NoError(err, "VersionOf should not return an error")
+	is.Equal(byte(3), v)
+	is.NoError(gen.(Validator).ValidateStrict(id, DefaultLength), "ValidateStrict should accept a versioned ID")
+
+	// IDs from other versions of the scheme can still be dispatched on.
+	other, err := NewGenerator(WithVersion(7))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	otherID, err := other.New(DefaultLength)
+	is.NoError(err, "New should not return an error")
+
+	v, err = gen.(Inspector).VersionOf(otherID)
+	is.NoError(err, "VersionOf should read versions other than the generator's own")
+	is.Equal(byte(7), v)
+	is.Equal(ErrVersionMismatch, gen.(Validator).ValidateStrict(otherID, DefaultLength), "Expected ErrVersionMismatch for another version")
+
+	_, err = gen.(Inspector).VersionOf("!abc")
+	is.Equal(ErrInvalidCharacter, err, "Expected ErrInvalidCharacter for a version character outside the alphabet")
+
+	_, err = gen.(Inspector).VersionOf(EmptyID)
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength for an empty ID")
+
+	_, err = Generator.(Inspector).VersionOf(id)
+	is.Equal(ErrNoVersion, err, "Expected ErrNoVersion without a configured version")
+}
+
+// TestGenerator_VersionOfDecorated tests the version alongside a prefix, region code and length prefix.
+func TestGenerator_VersionOfDecorated(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator(WithPrefix("ord_"), WithVersion(0), WithRegionCode("eu"), WithLengthPrefix(true))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	id, err := gen.New(10)
+	is.NoError(err, "New should not return an error")
+	is.Equal(ID("ord__eu"), id[:7], "The version should follow the prefix and precede the region code")
+
+	v, err := gen.(Inspector).VersionOf(id)
+	is.NoError(err, "VersionOf should not return an error")
+	is.Equal(byte(0), v)
+
+	region, err := gen.(Inspector).RegionOf(id)
+	is.NoError(err, "RegionOf should skip the version character")
+	is.Equal("eu", region)
+
+	n, err := gen.(Inspector).LengthOf(id)
+	is.NoError(err, "LengthOf should skip the version character")
+	is.Equal(10, n)
+
+	_, err = gen.(Validator).Parse(string(id))
+	is.NoError(err, "Parse should accept a versioned ID")
+
+	_, err = gen.(Inspector).VersionOf(id[4:])
+	is.Equal(ErrInvalidAffix, err, "Expected ErrInvalidAffix without the prefix")
+
+	gen, err = NewGenerator(WithPrefix("ord_"), WithSuffix("_x"), WithVersion(1))
+	is.NoError(err, "NewGenerator() should not return an error")
+
+	_, err = gen.(Inspector).VersionOf("ord_1abc_y")
+	is.Equal(ErrInvalidAffix, err, "Expected ErrInvalidAffix with the wrong suffix")
+}
+
+// TestWithVersionInvalid tests that versions beyond the alphabet are rejected.
+func TestWithVersionInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := NewGenerator(WithVersion(63))
+	is.NoError(err, "The highest version for the default alphabet should be accepted")
+
+	_, err = NewGenerator(WithVersion(64))
+	is.Equal(ErrInvalidVersion, err, "Expected ErrInvalidVersion beyond the alphabet length")
+
+	_, err = NewGenerator(WithAlphabet("0123456789"), WithVersion(10))
+	is.Equal(ErrInvalidVersion, err, "Expected ErrInvalidVersion beyond a decimal alphabet")
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"unicode/utf8"
+)
+
+// WordGenerator is implemented by generators that can generate memorable IDs made of dictionary
+// words.
+type WordGenerator interface {
+	// NewWords generates a memorable ID of 'count' words selected uniformly from 'dict'
+	// and joined by 'sep'. The dictionary must contain at least two unique, non-empty words.
+	//
+	// Usage:
+	//   id, err := generator.NewWords(3, []string{"brave", "falcon", "river"}, '-')
+	//   if err != nil {
+	//       // handle error
+	//   }
+	//   fmt.Println("Generated ID:", id)
+	NewWords(count int, dict []string, sep rune) (ID, error)
+}
+
+// NewWords generates a memorable ID composed of 'count' words drawn from the provided
+// dictionary and joined by 'sep', for example "brave-falcon-river".
+//
+// Each word is selected independently and uniformly using the generator's random reader,
+// so the ID carries count * log2(len(dict)) bits of entropy. Words may repeat.
+//
+// Parameters:
+//   - count int: The number of words in the generated ID.
+//   - dict []string: The dictionary of candidate words. It must contain at least
+//     MinAlphabetLength unique, non-empty words.
+//   - sep rune: The separator placed between consecutive words.
+//
+// Returns:
+//   - ID: The generated word-based ID.
+//   - error: An error object if the generation fails.
+//
+// Error Conditions:
+//   - ErrInvalidLength: Returned if count is less than or equal to zero.
+//   - ErrInvalidDictionary: Returned if the dictionary is too small, or contains empty or duplicate words.
+//   - ErrInvalidSeparator: Returned if sep is not a valid rune.
+//
+// Usage Example:
+//
+//	dict := []string{"brave", "falcon", "river", "stone"}
+//	id, err := generator.NewWords(3, dict, '-')
+//	if err != nil {
+//	    // handle error
+//	}
+//	fmt.Println("Generated ID:", id)
+func (g *generator) NewWords(count int, dict []string, sep rune) (ID, error) {
+	if count <= 0 {
+		return EmptyID, ErrInvalidLength
+	}
+
+	if !utf8.ValidRune(sep) {
+		return EmptyID, ErrInvalidSeparator
+	}
+
+	if err := validateDictionary(dict); err != nil {
+		return EmptyID, err
+	}
+
+	var buf []byte
+	for i := 0; i < count; i++ {
+		idx, err := g.randomIndex(len(dict))
+		if err != nil {
+			return EmptyID, err
+		}
+
+		if i > 0 {
+			buf = utf8.AppendRune(buf, sep)
+		}
+		buf = append(buf, dict[idx]...)
+	}
+
+	return ID(buf), nil
+}
+
+// validateDictionary ensures the dictionary has enough unique, non-empty words.
+func validateDictionary(dict []string) error {
+	if len(dict) < MinAlphabetLength {
+		return ErrInvalidDictionary
+	}
+
+	seen := make(map[string]struct{}, len(dict))
+	for _, word := range dict {
+		if word == "" {
+			return ErrInvalidDictionary
+		}
+		if _, ok := seen[word]; ok {
+			return ErrInvalidDictionary
+		}
+		seen[word] = struct{}{}
+	}
+
+	return nil
+}
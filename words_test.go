@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package nanoid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerator_NewWords tests that NewWords joins the requested number of dictionary words.
+func TestGenerator_NewWords(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dict := []string{"brave", "falcon", "river", "stone", "amber"}
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	for i := 0; i < 100; i++ {
+		id, err := gen.(WordGenerator).NewWords(4, dict, '-')
+		is.NoError(err, "NewWords should not return an error")
+
+		words := strings.Split(id.String(), "-")
+		is.Len(words, 4, "Generated ID should contain the requested number of words")
+		for _, w := range words {
+			is.Contains(dict, w, "Generated word should come from the dictionary")
+		}
+	}
+}
+
+// TestGenerator_NewWordsUnbiased tests that words are selected uniformly from a small dictionary.
+func TestGenerator_NewWordsUnbiased(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dict := []string{"red", "green", "blue"}
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	const draws = 30000
+	counts := make(map[string]int, len(dict))
+	for i := 0; i < draws; i++ {
+		id, err := gen.(WordGenerator).NewWords(1, dict, ' ')
+		is.NoError(err, "NewWords should not return an error")
+		counts[id.String()]++
+	}
+
+	expected := draws / len(dict)
+	for _, w := range dict {
+		is.InDelta(expected, counts[w], float64(expected)*0.05, "Word %q should be selected uniformly", w)
+	}
+}
+
+// TestGenerator_NewWordsInvalid tests that NewWords rejects invalid arguments.
+func TestGenerator_NewWordsInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gen, err := NewGenerator()
+	is.NoError(err, "NewGenerator() should not return an error with the default alphabet")
+
+	_, err = gen.(WordGenerator).NewWords(0, []string{"a", "b"}, '-')
+	is.Equal(ErrInvalidLength, err, "Expected ErrInvalidLength")
+
+	_, err = gen.(WordGenerator).NewWords(2, []string{"a"}, '-')
+	is.Equal(ErrInvalidDictionary, err, "Expected ErrInvalidDictionary for a single-word dictionary")
+
+	_, err = gen.(WordGenerator).NewWords(2, []string{"a", "a"}, '-')
+	is.Equal(ErrInvalidDictionary, err, "Expected ErrInvalidDictionary for duplicate words")
+
+	_, err = gen.(WordGenerator).NewWords(2, []string{"a", ""}, '-')
+	is.Equal(ErrInvalidDictionary, err, "Expected ErrInvalidDictionary for an empty word")
+
+	_, err = gen.(WordGenerator).NewWords(2, []string{"a", "b"}, -1)
+	is.Equal(ErrInvalidSeparator, err, "Expected ErrInvalidSeparator")
+}